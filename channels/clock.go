@@ -0,0 +1,23 @@
+package channels
+
+import "time"
+
+// clock provides the current time and pauses for a duration, so that the time based stages can be driven by a fake
+// clock in tests, rather than by the wall clock.
+type clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+}
+
+// wallClock is the clock used by the time based stages, backed by the time package.
+type wallClock struct{}
+
+// Now provides the current time.
+func (wallClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep pauses the calling goroutine for the given duration.
+func (wallClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
@@ -0,0 +1,44 @@
+package channels
+
+import (
+	"sync"
+	"time"
+)
+
+// fakeClock is a clock which only moves when told to.  Each call to Now first moves it on by the next of the given
+// steps, if any remain, and Sleep moves it on by the duration slept, recording each duration.
+type fakeClock struct {
+	lock  sync.Mutex
+	now   time.Time
+	steps []time.Duration
+	slept []time.Duration
+}
+
+// Now moves the clock on by the next step, if any remain, and provides the time.
+func (c *fakeClock) Now() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.steps) > 0 {
+		c.now = c.now.Add(c.steps[0])
+		c.steps = c.steps[1:]
+	}
+	return c.now
+}
+
+// Sleep moves the clock on by the given duration, without pausing.
+func (c *fakeClock) Sleep(d time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.now = c.now.Add(d)
+	c.slept = append(c.slept, d)
+}
+
+// sleeps provides each duration slept so far.
+func (c *fakeClock) sleeps() []time.Duration {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return append([]time.Duration(nil), c.slept...)
+}
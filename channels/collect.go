@@ -2,6 +2,7 @@ package channels
 
 import (
 	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
 )
 
 // BuildMapFromEntries takes a slice of maps.Entry and returns a map built from those entries.
@@ -23,6 +24,26 @@ func CollectAsSlice[T any](input <-chan T) []T {
 	return results
 }
 
+// CollectInto reads all elements from the input channel and appends them to the given slice, reusing its capacity, and
+// returns the grown slice.  The given slice is reset to a length of zero first, so any elements it held are overwritten.
+// This function will block until the input channel is closed.
+func CollectInto[T any](input <-chan T, dst []T) []T {
+	dst = dst[:0]
+	for el := range input {
+		dst = append(dst, el)
+	}
+	return dst
+}
+
+// CollectSorted reads all elements from the input channel into a slice, then sorts that slice in place using the given
+// less function and returns it.  Every element is held in memory until the input channel is closed, so this is only
+// suitable for bounded streams.  This function will block until the input channel is closed.
+func CollectSorted[T any](input <-chan T, less func(a, b T) bool) []T {
+	results := CollectAsSlice(input)
+	slices.SortInPlace(results, less)
+	return results
+}
+
 // CollectNAsSlice reads all elements from the input channel and returns them as a slice. This function will block until
 // the input channel is closed.
 func CollectNAsSlice[T any](input <-chan T, howMany int) []T {
@@ -52,3 +73,14 @@ func CollectAsMap[I any, OK comparable, OV any](input <-chan I, fn MapBuilderFun
 	}
 	return results
 }
+
+// Count reads all elements from the input channel, discarding them, and returns how many were read.  No elements are
+// retained, so this uses constant memory however many elements pass through.  This function will block until the input
+// channel is closed.
+func Count[T any](input <-chan T) int {
+	count := 0
+	for range input {
+		count++
+	}
+	return count
+}
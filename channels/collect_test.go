@@ -106,6 +106,132 @@ func TestCollectAsSlice(t *testing.T) {
 	}
 }
 
+func ExampleCollectInto() {
+	buffer := make([]int, 0, 8)
+
+	for _, batch := range [][]int{{1, 2, 3}, {4, 5}} {
+		buffer = channels.CollectInto(channels.FromSlice(batch), buffer)
+		fmt.Printf("collected: %v, capacity: %d\n", buffer, cap(buffer))
+	}
+	// Output:
+	// collected: [1 2 3], capacity: 8
+	// collected: [4 5], capacity: 8
+}
+
+func TestCollectInto(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		dst   []T
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "collects into an empty slice",
+			args: args[int]{
+				input: []int{1, 2, 3},
+				dst:   make([]int, 0, 2),
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "overwrites existing elements of the slice",
+			args: args[int]{
+				input: []int{4, 5},
+				dst:   []int{1, 2, 3},
+			},
+			want: []int{4, 5},
+		},
+		{
+			name: "nil slice is grown as needed",
+			args: args[int]{
+				input: []int{1},
+				dst:   nil,
+			},
+			want: []int{1},
+		},
+		{
+			name: "empty input resets the slice",
+			args: args[int]{
+				input: []int{},
+				dst:   []int{1, 2, 3},
+			},
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectInto(channels.FromSlice(tt.args.input), tt.args.dst)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CollectInto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectInto_ReusesCapacity(t *testing.T) {
+	dst := make([]int, 0, 10)
+	got := channels.CollectInto(channels.FromSlice([]int{1, 2, 3}), dst)
+	if &got[0] != &dst[:1][0] {
+		t.Errorf("CollectInto() allocated a new backing array, want the given slice to be reused")
+	}
+}
+
+func ExampleCollectSorted() {
+	input := channels.FromSlice([]int{5, 1, 4, 2, 3})
+	doubled := channels.MapStage(input, channels.MapOptions{Workers: 3}, func(element int) int {
+		return element * 2
+	})
+
+	results := channels.CollectSorted(doubled, func(a, b int) bool {
+		return a < b
+	})
+
+	fmt.Printf("Results: %v", results)
+	// Output: Results: [2 4 6 8 10]
+}
+
+func TestCollectSorted(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		less  func(a, b T) bool
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[string]{
+		{
+			name: "collects and sorts every element",
+			args: args[string]{
+				input: []string{"pear", "apple", "fig"},
+				less:  func(a, b string) bool { return a < b },
+			},
+			want: []string{"apple", "fig", "pear"},
+		},
+		{
+			name: "empty input produces nil output",
+			args: args[string]{
+				input: []string{},
+				less:  func(a, b string) bool { return a < b },
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectSorted(channels.FromSlice(tt.args.input), tt.args.less)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CollectSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleCollectAsMap() {
 	input := channels.FromSlice([]string{"hello", "generous", "and", "glorious", "world"})
 	output := channels.CollectAsMap(input, func(element string) maps.Entry[string, int] {
@@ -230,3 +356,36 @@ func ExampleCollectNAsSlice() {
 	fmt.Printf("result: %v", output)
 	// Output: result: [2 4 6]
 }
+
+func ExampleCount() {
+	input := channels.FromSlice([]string{"a", "b", "c"})
+
+	fmt.Printf("count: %v", channels.Count(input))
+	// Output: count: 3
+}
+
+func TestCount(t *testing.T) {
+	tests := []struct {
+		name  string
+		input <-chan int
+		want  int
+	}{
+		{
+			name:  "counts every element",
+			input: channels.FromSlice([]int{1, 2, 3, 4}),
+			want:  4,
+		},
+		{
+			name:  "nil input counts zero",
+			input: channels.FromSlice[int](nil),
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := channels.Count(tt.input); got != tt.want {
+				t.Errorf("Count() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
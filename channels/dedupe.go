@@ -0,0 +1,48 @@
+package channels
+
+import "time"
+
+// DedupeWindow reads all elements from the input channel and writes them to the output channel, except for those whose
+// key, as given by the key function, was already written within the last window of time.  The window is measured from
+// the last element written with that key, so a key which keeps repeating is written again once per window, rather than
+// being suppressed for as long as it repeats.  Keys are forgotten once their window has passed, so memory is bounded
+// by the number of distinct keys written within a single window.  The output channel is closed once the input channel
+// is closed.
+func DedupeWindow[T any, K comparable](input <-chan T, window time.Duration, keyFn func(element T) K) <-chan T {
+	return dedupeWindow(input, window, func(element T) (K, bool) {
+		return keyFn(element), true
+	}, wallClock{})
+}
+
+// dedupeWindow implements DedupeWindow, skipping any element for which the key function reports false, and reading the
+// time from the given clock.
+func dedupeWindow[T any, K comparable](input <-chan T, window time.Duration, keyFn func(element T) (K, bool), clock clock) <-chan T {
+	type sighting struct {
+		key  K
+		seen time.Time
+	}
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		lastSeen := map[K]time.Time{}
+		var order []sighting
+		for element := range input {
+			now := clock.Now()
+			for len(order) > 0 && now.Sub(order[0].seen) >= window {
+				delete(lastSeen, order[0].key)
+				order = order[1:]
+			}
+			key, ok := keyFn(element)
+			if !ok {
+				continue
+			}
+			if _, seen := lastSeen[key]; seen {
+				continue
+			}
+			lastSeen[key] = now
+			order = append(order, sighting{key: key, seen: now})
+			output <- element
+		}
+	}()
+	return output
+}
@@ -0,0 +1,76 @@
+package channels
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDedupeWindow_Clock(t *testing.T) {
+	type alert struct {
+		Name     string
+		Severity int
+	}
+	tests := []struct {
+		name  string
+		input []alert
+		steps []time.Duration
+		want  []alert
+	}{
+		{
+			name: "suppresses repeats within the window",
+			input: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "disk", Severity: 2},
+				{Name: "cpu", Severity: 1},
+				{Name: "disk", Severity: 3},
+			},
+			steps: []time.Duration{0, 10 * time.Second, 10 * time.Second, 30 * time.Second},
+			want: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "cpu", Severity: 1},
+			},
+		},
+		{
+			name: "passes repeats again once the window has passed",
+			input: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "cpu", Severity: 1},
+				{Name: "disk", Severity: 2},
+				{Name: "cpu", Severity: 2},
+			},
+			steps: []time.Duration{0, 30 * time.Second, 30 * time.Second, 10 * time.Second},
+			want: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "cpu", Severity: 1},
+				{Name: "disk", Severity: 2},
+			},
+		},
+		{
+			name: "window is measured from the last element passed, not the last repeat",
+			input: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "disk", Severity: 2},
+				{Name: "disk", Severity: 3},
+			},
+			steps: []time.Duration{0, 45 * time.Second, 15 * time.Second},
+			want: []alert{
+				{Name: "disk", Severity: 1},
+				{Name: "disk", Severity: 3},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{steps: tt.steps}
+			output := dedupeWindow(FromSlice(tt.input), time.Minute, func(element alert) (string, bool) {
+				return element.Name, true
+			}, clock)
+
+			got := CollectAsSlice(output)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dedupeWindow() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
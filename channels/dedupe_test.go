@@ -0,0 +1,21 @@
+package channels_test
+
+import (
+	"github.com/pickeringtech/go-collections/channels"
+	"testing"
+	"time"
+)
+
+type alert struct {
+	Name     string
+	Severity int
+}
+
+func TestDedupeWindow_Empty(t *testing.T) {
+	output := channels.DedupeWindow(channels.FromSlice[alert](nil), time.Minute, func(element alert) string {
+		return element.Name
+	})
+	if got := channels.CollectAsSlice(output); got != nil {
+		t.Errorf("DedupeWindow() = %v, want nil", got)
+	}
+}
@@ -0,0 +1,70 @@
+package channels
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ItemError is an error which occurred while processing an element of a channel, holding the element which caused it
+// so that it can be logged or retried.
+type ItemError[T any] struct {
+	// Element is the element which was being processed when the error occurred.
+	Element T
+	// Err is the underlying error.
+	Err error
+}
+
+// Error describes the underlying error, along with the element which caused it.
+func (e ItemError[T]) Error() string {
+	return fmt.Sprintf("%v: %v", e.Element, e.Err)
+}
+
+// Unwrap provides the underlying error, so that ItemError can be used with errors.Is and errors.As.
+func (e ItemError[T]) Unwrap() error {
+	return e.Err
+}
+
+// PlainErrors provides the underlying error of each of the given ItemErrors, in the same order.
+func PlainErrors[T any](itemErrors []ItemError[T]) []error {
+	var results []error
+	for _, itemError := range itemErrors {
+		results = append(results, itemError.Err)
+	}
+	return results
+}
+
+// errorSink gathers the errors reported by the stages of a Pipeline, so that they can be returned alongside its
+// results.
+type errorSink[T any] struct {
+	lock   *sync.Mutex
+	wg     *sync.WaitGroup
+	errors []ItemError[T]
+}
+
+func newErrorSink[T any]() *errorSink[T] {
+	return &errorSink[T]{
+		lock: &sync.Mutex{},
+		wg:   &sync.WaitGroup{},
+	}
+}
+
+// drain reads every error from the given channel into the sink in the background, until the channel is closed.
+func (s *errorSink[T]) drain(errors <-chan ItemError[T]) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for err := range errors {
+			s.lock.Lock()
+			s.errors = append(s.errors, err)
+			s.lock.Unlock()
+		}
+	}()
+}
+
+// collect waits for every drained channel to be closed, then returns the errors gathered by the sink.
+func (s *errorSink[T]) collect() []ItemError[T] {
+	s.wg.Wait()
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.errors
+}
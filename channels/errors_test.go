@@ -0,0 +1,58 @@
+package channels_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pickeringtech/go-collections/channels"
+	"reflect"
+	"testing"
+)
+
+func ExampleItemError() {
+	errTimeout := errors.New("timed out")
+	err := channels.ItemError[string]{Element: "webhook-42", Err: errTimeout}
+
+	fmt.Printf("error: %v, is timeout: %v", err, errors.Is(err, errTimeout))
+	// Output: error: webhook-42: timed out, is timeout: true
+}
+
+func ExamplePlainErrors() {
+	itemErrors := []channels.ItemError[int]{
+		{Element: 1, Err: errors.New("first")},
+		{Element: 2, Err: errors.New("second")},
+	}
+
+	fmt.Printf("errors: %v", channels.PlainErrors(itemErrors))
+	// Output: errors: [first second]
+}
+
+func TestPlainErrors(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	tests := []struct {
+		name       string
+		itemErrors []channels.ItemError[int]
+		want       []error
+	}{
+		{
+			name: "provides the underlying errors in order",
+			itemErrors: []channels.ItemError[int]{
+				{Element: 1, Err: errA},
+				{Element: 2, Err: errB},
+			},
+			want: []error{errA, errB},
+		},
+		{
+			name:       "nil input provides nil output",
+			itemErrors: nil,
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.PlainErrors(tt.itemErrors)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PlainErrors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package channels
+
+import "github.com/pickeringtech/go-collections/maps"
+
+// Zip reads one element from each of the two input channels in turn, pairing them into a maps.Entry which is written
+// to the output channel - the element from a as the key, and the element from b as the value.  Pairing is synchronous:
+// an element read from a waits for the matching element of b to arrive, so the output moves at the pace of the slower
+// input.  The output channel is closed as soon as either input channel is closed, at which point the stopped channel is
+// closed too.  A sender of an unbounded input should watch the stopped channel, and stop producing and close its input
+// once it is closed - until then, any elements remaining in the other input are read and discarded in the background,
+// so that its sender is not left blocked.
+func Zip[A comparable, B any](a <-chan A, b <-chan B) (output <-chan maps.Entry[A, B], stopped <-chan struct{}) {
+	results := make(chan maps.Entry[A, B])
+	stop := make(chan struct{})
+	go func() {
+		defer close(results)
+		defer close(stop)
+		for {
+			key, ok := <-a
+			if !ok {
+				go discard(b)
+				return
+			}
+			value, ok := <-b
+			if !ok {
+				go discard(a)
+				return
+			}
+			results <- maps.Entry[A, B]{Key: key, Value: value}
+		}
+	}()
+	return results, stop
+}
+
+// discard reads and drops every element of the input channel until it is closed.
+func discard[T any](input <-chan T) {
+	for range input {
+	}
+}
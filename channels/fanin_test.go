@@ -0,0 +1,109 @@
+package channels_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/channels"
+	"github.com/pickeringtech/go-collections/maps"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func ExampleZip() {
+	requestIDs := channels.FromSlice([]string{"req-1", "req-2", "req-3"})
+	responses := channels.FromSlice([]int{200, 404, 500})
+
+	pairs, _ := channels.Zip(requestIDs, responses)
+	for pair := range pairs {
+		fmt.Printf("%v: %v\n", pair.Key, pair.Value)
+	}
+	// Output:
+	// req-1: 200
+	// req-2: 404
+	// req-3: 500
+}
+
+func TestZip(t *testing.T) {
+	type args[A comparable, B any] struct {
+		a []A
+		b []B
+	}
+	type testCase[A comparable, B any] struct {
+		name string
+		args args[A, B]
+		want []maps.Entry[A, B]
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "pairs elements in order",
+			args: args[string, int]{
+				a: []string{"a", "b"},
+				b: []int{1, 2},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name: "stops when the first input closes",
+			args: args[string, int]{
+				a: []string{"a"},
+				b: []int{1, 2, 3},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}},
+		},
+		{
+			name: "stops when the second input closes",
+			args: args[string, int]{
+				a: []string{"a", "b", "c"},
+				b: []int{1, 2},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name: "empty input produces nil output",
+			args: args[string, int]{
+				a: []string{},
+				b: []int{1},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, _ := channels.Zip(channels.FromSlice(tt.args.a), channels.FromSlice(tt.args.b))
+			got := channels.CollectAsSlice(output)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Zip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestZip_StopsUnboundedInput(t *testing.T) {
+	a := channels.FromSlice([]string{"a", "b"})
+	b := make(chan int)
+	output, stopped := channels.Zip(a, b)
+
+	senderDone := make(chan struct{})
+	go func() {
+		defer close(senderDone)
+		defer close(b)
+		for i := 0; ; i++ {
+			select {
+			case b <- i:
+			case <-stopped:
+				return
+			}
+		}
+	}()
+
+	got := channels.CollectAsSlice(output)
+	want := []maps.Entry[string, int]{{Key: "a", Value: 0}, {Key: "b", Value: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Zip() = %v, want %v", got, want)
+	}
+	select {
+	case <-senderDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Zip() did not stop the unbounded sender")
+	}
+}
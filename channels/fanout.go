@@ -0,0 +1,48 @@
+package channels
+
+// Broadcast reads all elements from the input channel and writes each of them to every one of n output channels, so
+// that each consumer receives its own copy of the stream.  Elements are written to the outputs in turn, so a slow
+// consumer holds back the others: every output must be read concurrently to avoid blocking.  All the outputs are
+// closed once the input channel is closed.  If n is zero or less, nil is returned and the input is not read.
+func Broadcast[T any](input <-chan T, n int) []<-chan T {
+	return broadcast(input, n, 0, false)
+}
+
+// BroadcastWithDrop reads all elements from the input channel and writes each of them to every one of n output
+// channels, each buffered to hold the given number of elements.  Rather than holding back the other consumers, an
+// element is dropped for any output whose buffer is full, so a slow consumer misses elements instead of applying
+// backpressure.  All the outputs are closed once the input channel is closed.  If n is zero or less, nil is returned
+// and the input is not read.
+func BroadcastWithDrop[T any](input <-chan T, n int, bufferSize int) []<-chan T {
+	return broadcast(input, n, bufferSize, true)
+}
+
+func broadcast[T any](input <-chan T, n int, bufferSize int, drop bool) []<-chan T {
+	if n <= 0 {
+		return nil
+	}
+	outputs := make([]chan T, n)
+	results := make([]<-chan T, n)
+	for i := range outputs {
+		outputs[i] = make(chan T, bufferSize)
+		results[i] = outputs[i]
+	}
+	go func() {
+		for element := range input {
+			for _, output := range outputs {
+				if !drop {
+					output <- element
+					continue
+				}
+				select {
+				case output <- element:
+				default:
+				}
+			}
+		}
+		for _, output := range outputs {
+			close(output)
+		}
+	}()
+	return results
+}
@@ -0,0 +1,114 @@
+package channels_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/channels"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func ExampleBroadcast() {
+	input := channels.FromSlice([]string{"created", "updated", "deleted"})
+	outputs := channels.Broadcast(input, 2)
+
+	// Every output must be read at the same time.
+	var persisted []string
+	done := make(chan struct{})
+	go func() {
+		persisted = channels.CollectAsSlice(outputs[0])
+		close(done)
+	}()
+	metrics := len(channels.CollectAsSlice(outputs[1]))
+	<-done
+
+	fmt.Printf("persisted: %v, metrics: %v", persisted, metrics)
+	// Output: persisted: [created updated deleted], metrics: 3
+}
+
+func TestBroadcast(t *testing.T) {
+	type args[T any] struct {
+		input <-chan T
+		n     int
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want [][]T
+	}
+	tests := []testCase[int]{
+		{
+			name: "every output receives every element",
+			args: args[int]{
+				input: channels.FromSlice([]int{1, 2, 3}),
+				n:     3,
+			},
+			want: [][]int{{1, 2, 3}, {1, 2, 3}, {1, 2, 3}},
+		},
+		{
+			name: "empty input closes every output",
+			args: args[int]{
+				input: channels.FromSlice([]int{}),
+				n:     2,
+			},
+			want: [][]int{nil, nil},
+		},
+		{
+			name: "zero outputs provides nil",
+			args: args[int]{
+				input: channels.FromSlice([]int{1}),
+				n:     0,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			outputs := channels.Broadcast(tt.args.input, tt.args.n)
+			got := collectConcurrently(outputs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Broadcast() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBroadcastWithDrop(t *testing.T) {
+	input := make(chan int)
+	outputs := channels.BroadcastWithDrop(input, 2, 2)
+
+	// Only the first output is read, so the second output's buffer fills and further elements are dropped for it.
+	var fast []int
+	for i := 1; i <= 4; i++ {
+		input <- i
+		fast = append(fast, <-outputs[0])
+	}
+	close(input)
+	fast = append(fast, channels.CollectAsSlice(outputs[0])...)
+	slow := channels.CollectAsSlice(outputs[1])
+
+	if !reflect.DeepEqual(fast, []int{1, 2, 3, 4}) {
+		t.Errorf("BroadcastWithDrop() fast output = %v, want %v", fast, []int{1, 2, 3, 4})
+	}
+	if !reflect.DeepEqual(slow, []int{1, 2}) {
+		t.Errorf("BroadcastWithDrop() slow output = %v, want %v", slow, []int{1, 2})
+	}
+}
+
+func collectConcurrently[T any](outputs []<-chan T) [][]T {
+	if outputs == nil {
+		return nil
+	}
+	results := make([][]T, len(outputs))
+	wg := sync.WaitGroup{}
+	for i, output := range outputs {
+		i, output := i, output
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = channels.CollectAsSlice(output)
+		}()
+	}
+	wg.Wait()
+	return results
+}
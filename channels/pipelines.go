@@ -1,24 +1,237 @@
 package channels
 
+import (
+	"sync"
+	"time"
+)
+
 // Pipeline represents a channel backed pipeline, with a given start and end channel.  This type is useful for ensuring
 // that a given pipeline starts and ends with a given type, but the operations which occur in the middle of the pipeline
 // (i.e. how an input is converted into the required output) are not specified.
 type Pipeline[I, O any] struct {
-	start <-chan I
-	end   <-chan O
+	start   <-chan I
+	end     <-chan O
+	metrics *pipelineMetrics
+	errors  *errorSink[O]
+	// stage is the name of the stage which produces the end channel.
+	stage string
+	// stopping is shared by every pipeline derived from the same NewPipeline call, and signals that the pipeline has
+	// been ended early.
+	stopping *stopSignal
+	// recoverPanics, when set, is called with each panic recovered from the functions of the stages.
+	recoverPanics func(recovered any, element O)
 }
 
 // PipelineCreationFunc is a function which takes a channel of the input type and returns a channel of the output type.
 type PipelineCreationFunc[I, O any] func(input <-chan I) <-chan O
 
+// PipelineHooks holds optional callbacks which are fired as elements flow through the stages of a Pipeline.  Any of
+// the callbacks may be left nil, in which case they are simply not called.  Each stage runs in its own goroutine, but
+// the calls made by the stages of a pipeline are serialised, so the callbacks never run concurrently with each other
+// and may update plain, unsynchronised state.  As every stage waits for the callbacks, they should return quickly.
+type PipelineHooks struct {
+	// OnItemProcessed is called each time a stage emits an element, receiving the name of that stage.
+	OnItemProcessed func(stage string)
+	// OnStageComplete is called once a stage has finished, receiving the name of the stage and the number of elements
+	// it emitted.
+	OnStageComplete func(stage string, count int)
+}
+
 // NewPipeline creates a new Pipeline, with the given input channel and PipelineCreationFunc.  The PipelineCreationFunc
-// is used to create the end channel of the pipeline.
+// is used to create the end channel of the pipeline.  Elements of the input channel are passed to the
+// PipelineCreationFunc until the pipeline is ended early, such as by a TakeWhile stage - see Done.
 func NewPipeline[I, O any](input <-chan I, fn PipelineCreationFunc[I, O]) *Pipeline[I, O] {
-	end := fn(input)
+	stopping := newStopSignal()
+	end := fn(until(input, stopping.done))
+	return &Pipeline[I, O]{
+		start:    input,
+		end:      end,
+		errors:   newErrorSink[O](),
+		stage:    "creation",
+		stopping: stopping,
+	}
+}
+
+// Done provides a channel which is closed once the pipeline has been ended early, such as by a TakeWhile stage.  At that
+// point the input channel given to NewPipeline is no longer passed on, so every stage of the pipeline finishes.  A
+// sender of an unbounded input should watch this channel, and stop producing and close the input once it is closed -
+// until then, the rest of the input is read and discarded in the background, so that the sender is not left blocked.
+func (p Pipeline[I, O]) Done() <-chan struct{} {
+	return p.stopping.done
+}
+
+// WithMetrics returns a new Pipeline which reports its progress to the given hooks.  The elements produced by the
+// latest stage of this pipeline are reported under its name - "creation" for the PipelineCreationFunc - and each stage
+// added to the returned pipeline afterwards is reported under its own name.  Stages added before this call are not
+// otherwise instrumented, so call WithMetrics straight after NewPipeline to see every stage.  Pipelines without hooks
+// are not instrumented, so carry no overhead.
+func (p Pipeline[I, O]) WithMetrics(hooks PipelineHooks) *Pipeline[I, O] {
+	metrics := &pipelineMetrics{hooks: hooks}
 	return &Pipeline[I, O]{
-		start: input,
-		end:   end,
+		start:         p.start,
+		end:           observe(p.end, p.stage, metrics),
+		metrics:       metrics,
+		errors:        p.errors,
+		stage:         p.stage,
+		stopping:      p.stopping,
+		recoverPanics: p.recoverPanics,
+	}
+}
+
+// RecoverPanics returns a new Pipeline in which a panic within the function of any stage added afterwards is recovered,
+// rather than crashing the program.  The handler is called with the recovered value and the element which caused it,
+// and the stage then continues with the next element - a recovered stage never produces an output for the offending
+// element, and for FilterWithError and MapWithError no error is gathered for it either.  A Scan stage keeps its
+// accumulator as it was before the offending element.  Functions run within the PipelineCreationFunc, or by stages
+// added before this one, are not covered.
+func (p Pipeline[I, O]) RecoverPanics(handler func(recovered any, element O)) *Pipeline[I, O] {
+	return &Pipeline[I, O]{
+		start:         p.start,
+		end:           p.end,
+		metrics:       p.metrics,
+		errors:        p.errors,
+		stage:         p.stage,
+		stopping:      p.stopping,
+		recoverPanics: handler,
+	}
+}
+
+// Filter returns a new Pipeline which only includes the elements for which the given function returns true.  The
+// function is accepted in its plain func(O) bool form, so a FilterFunc or a predicate declared with the slices package
+// FindFunc or FilterFunc types can be given directly.  The stage is named "filter".
+func (p Pipeline[I, O]) Filter(fn func(element O) bool) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		return p.then("filter", recoverEach(p.end, p.recoverPanics, func(element O) (O, bool, bool) {
+			return element, fn(element), false
+		}))
+	}
+	return p.then("filter", Filter(p.end, fn))
+}
+
+// FilterNot returns a new Pipeline which only includes the elements for which the given function returns false,
+// accepting the function in the same form as Filter.  The stage is named "filterNot".
+func (p Pipeline[I, O]) FilterNot(fn func(element O) bool) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		return p.then("filterNot", recoverEach(p.end, p.recoverPanics, func(element O) (O, bool, bool) {
+			return element, !fn(element), false
+		}))
+	}
+	return p.then("filterNot", FilterNot(p.end, fn))
+}
+
+// FilterWithError returns a new Pipeline which only includes the elements for which the given FilterWithErrorFunc
+// returns true.  Errors returned by the FilterWithErrorFunc are gathered, and can be retrieved with CollectWithErrors -
+// an element which caused an error is always dropped from the pipeline.  The stage is named "filterWithError".
+func (p Pipeline[I, O]) FilterWithError(fn FilterWithErrorFunc[O]) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		fn = recoverErrors(p.recoverPanics, fn)
+	}
+	output, errors := FilterWithError(p.end, fn)
+	p.errors.drain(withoutRecovered(errors))
+	return p.then("filterWithError", output)
+}
+
+// MapWithError returns a new Pipeline which transforms each element using the given MapWithErrorFunc.  Errors returned
+// by the MapWithErrorFunc are gathered, and can be retrieved with CollectWithErrors - an element which caused an error
+// is dropped from the pipeline.  The stage is named "mapWithError".
+func (p Pipeline[I, O]) MapWithError(fn MapWithErrorFunc[O, O]) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		fn = recoverErrors(p.recoverPanics, fn)
+	}
+	output, errors := MapWithError(p.end, fn)
+	p.errors.drain(withoutRecovered(errors))
+	return p.then("mapWithError", output)
+}
+
+// DedupeWindow returns a new Pipeline which drops elements whose key, as given by the key function, was already passed
+// on within the last window of time - see the package level DedupeWindow for how the window is measured.  As methods
+// cannot introduce type parameters, keys are given as any, and must hold comparable values, otherwise the stage panics;
+// use the package level DedupeWindow within a PipelineCreationFunc for a statically typed key.  The stage is named
+// "dedupeWindow".
+func (p Pipeline[I, O]) DedupeWindow(window time.Duration, keyFn func(element O) any) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		keyFn := recoverErrors(p.recoverPanics, func(element O) (any, error) {
+			return keyFn(element), nil
+		})
+		return p.then("dedupeWindow", dedupeWindow(p.end, window, func(element O) (any, bool) {
+			key, err := keyFn(element)
+			return key, err == nil
+		}, wallClock{}))
+	}
+	return p.then("dedupeWindow", DedupeWindow(p.end, window, keyFn))
+}
+
+// DropWhile returns a new Pipeline which discards the leading run of elements for which the given FilterFunc returns
+// true, then includes every element after it.  The stage is named "dropWhile".
+func (p Pipeline[I, O]) DropWhile(fn FilterFunc[O]) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		dropping := true
+		return p.then("dropWhile", recoverEach(p.end, p.recoverPanics, func(element O) (O, bool, bool) {
+			if dropping && fn(element) {
+				return element, false, false
+			}
+			dropping = false
+			return element, true, false
+		}))
+	}
+	return p.then("dropWhile", DropWhile(p.end, fn))
+}
+
+// MapStage returns a new Pipeline which transforms each element using the given MapFunc, run as configured by the given
+// MapOptions - see the package level MapStage for the workers, ordering and buffering they control.  Use the package
+// level MapStage within a PipelineCreationFunc when the results are of a different type to the elements.  With more
+// than one worker, a handler given to RecoverPanics may be called concurrently.  The stage is named "mapStage".
+func (p Pipeline[I, O]) MapStage(opts MapOptions, fn MapFunc[O, O]) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		return p.then("mapStage", recoverMap(p.end, opts, p.recoverPanics, fn))
+	}
+	return p.then("mapStage", MapStage(p.end, opts, fn))
+}
+
+// Scan returns a new Pipeline which replaces each element with the running accumulator produced by the given
+// ReduceFunc, starting from the initial value.  Use the package level Scan within a PipelineCreationFunc when the
+// accumulator is of a different type to the elements.  The stage is named "scan".
+func (p Pipeline[I, O]) Scan(initial O, fn ReduceFunc[O, O]) *Pipeline[I, O] {
+	if p.recoverPanics != nil {
+		accumulator := initial
+		return p.then("scan", recoverEach(p.end, p.recoverPanics, func(element O) (O, bool, bool) {
+			accumulator = fn(accumulator, element)
+			return accumulator, true, false
+		}))
 	}
+	return p.then("scan", Scan(p.end, initial, fn))
+}
+
+// RateLimitBurst returns a new Pipeline which passes elements on at a sustained rate of at most ratePerSec elements
+// per second, while allowing bursts of up to burst elements to pass immediately.  Elements wait for the rate to allow
+// them, applying backpressure to the earlier stages.  See the package level RateLimitBurst for the handling of
+// out of range arguments.  The stage is named "rateLimitBurst".
+func (p Pipeline[I, O]) RateLimitBurst(ratePerSec float64, burst int) *Pipeline[I, O] {
+	return p.then("rateLimitBurst", RateLimitBurst(p.end, ratePerSec, burst))
+}
+
+// TakeWhile returns a new Pipeline which includes elements for as long as the given FilterFunc returns true, ending the
+// pipeline at the first element for which it returns false.  The pipeline then stops passing on its input, closing the
+// channel provided by Done, so that the earlier stages finish rather than being left running.  The stage is named
+// "takeWhile".
+func (p Pipeline[I, O]) TakeWhile(fn FilterFunc[O]) *Pipeline[I, O] {
+	keep := func(element O) bool {
+		if fn(element) {
+			return true
+		}
+		p.stopping.stop()
+		return false
+	}
+	if p.recoverPanics != nil {
+		return p.then("takeWhile", recoverEach(p.end, p.recoverPanics, func(element O) (O, bool, bool) {
+			if !keep(element) {
+				return element, false, true
+			}
+			return element, true, false
+		}))
+	}
+	output, _ := TakeWhile(p.end, keep)
+	return p.then("takeWhile", output)
 }
 
 // CollectAsSlice collects all elements from the end channel of the pipeline into a slice, which is returned.  This
@@ -26,3 +239,139 @@ func NewPipeline[I, O any](input <-chan I, fn PipelineCreationFunc[I, O]) *Pipel
 func (p Pipeline[I, O]) CollectAsSlice() []O {
 	return CollectAsSlice(p.end)
 }
+
+// CollectInto collects all elements from the end channel of the pipeline into the given slice, reusing its capacity,
+// and returns the grown slice.  The given slice is reset to a length of zero first, so any elements it held are
+// overwritten.  This function will block until the end channel is closed.
+func (p Pipeline[I, O]) CollectInto(dst []O) []O {
+	return CollectInto(p.end, dst)
+}
+
+// CollectSorted collects all elements from the end channel of the pipeline into a slice, sorted using the given less
+// function.  Every element is held in memory until the end channel is closed, so this is only suitable for bounded
+// streams.  This function will block until the end channel is closed.
+func (p Pipeline[I, O]) CollectSorted(less func(a, b O) bool) []O {
+	return CollectSorted(p.end, less)
+}
+
+// Count reads all elements from the end channel of the pipeline, discarding them, and returns how many there were.  No
+// elements are retained, so this uses constant memory however many elements pass through.  This function will block
+// until the end channel is closed.
+func (p Pipeline[I, O]) Count() int {
+	return Count(p.end)
+}
+
+// CollectWithErrors collects all elements from the end channel of the pipeline into a slice, along with every error
+// reported by the stages of the pipeline.  Each error holds the element which caused it - use PlainErrors if only the
+// underlying errors are needed.  This function will block until the end channel is closed and every stage has finished
+// reporting errors.
+func (p Pipeline[I, O]) CollectWithErrors() ([]O, []ItemError[O]) {
+	results := CollectAsSlice(p.end)
+	return results, p.errors.collect()
+}
+
+// then creates a new Pipeline whose end channel is the given end channel, carrying over the start channel, hooks and
+// errors of this pipeline.  If hooks are configured, the new stage is instrumented under the given stage name.
+func (p Pipeline[I, O]) then(stage string, end <-chan O) *Pipeline[I, O] {
+	if p.metrics != nil {
+		end = observe(end, stage, p.metrics)
+	}
+	return &Pipeline[I, O]{
+		start:         p.start,
+		end:           end,
+		metrics:       p.metrics,
+		errors:        p.errors,
+		stage:         stage,
+		stopping:      p.stopping,
+		recoverPanics: p.recoverPanics,
+	}
+}
+
+// stopSignal closes its done channel the first time it is stopped.
+type stopSignal struct {
+	done chan struct{}
+	once sync.Once
+}
+
+// newStopSignal creates a new stopSignal which has not yet been stopped.
+func newStopSignal() *stopSignal {
+	return &stopSignal{done: make(chan struct{})}
+}
+
+// stop closes the done channel, if it has not already been closed.
+func (s *stopSignal) stop() {
+	s.once.Do(func() {
+		close(s.done)
+	})
+}
+
+// until forwards every element of the input channel to the output channel until the done channel is closed.  The
+// output channel is then closed, and the rest of the input is read and discarded in the background.
+func until[T any](input <-chan T, done <-chan struct{}) <-chan T {
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		for {
+			select {
+			case <-done:
+				go discard(input)
+				return
+			case element, ok := <-input:
+				if !ok {
+					return
+				}
+				select {
+				case output <- element:
+				case <-done:
+					go discard(input)
+					return
+				}
+			}
+		}
+	}()
+	return output
+}
+
+// pipelineMetrics holds the hooks of a pipeline, along with the lock which serialises the calls made to them by its
+// stages.
+type pipelineMetrics struct {
+	hooks PipelineHooks
+	lock  sync.Mutex
+}
+
+// itemProcessed reports an element emitted by the given stage to the OnItemProcessed hook, if there is one.
+func (m *pipelineMetrics) itemProcessed(stage string) {
+	if m.hooks.OnItemProcessed == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.hooks.OnItemProcessed(stage)
+}
+
+// stageComplete reports the completion of the given stage to the OnStageComplete hook, if there is one.
+func (m *pipelineMetrics) stageComplete(stage string, count int) {
+	if m.hooks.OnStageComplete == nil {
+		return
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.hooks.OnStageComplete(stage, count)
+}
+
+// observe forwards every element of the input channel to the output channel, reporting each element and the completion
+// of the stage to the given hooks.
+func observe[T any](input <-chan T, stage string, metrics *pipelineMetrics) <-chan T {
+	output := make(chan T)
+	go func() {
+		count := 0
+		for element := range input {
+			count++
+			metrics.itemProcessed(stage)
+			output <- element
+		}
+		metrics.stageComplete(stage, count)
+		close(output)
+	}()
+	return output
+}
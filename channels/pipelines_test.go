@@ -1,11 +1,14 @@
 package channels_test
 
 import (
+	"errors"
 	"fmt"
 	"github.com/pickeringtech/go-collections/channels"
+	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
 	"strconv"
 	"testing"
+	"time"
 )
 
 func ExamplePipeline_CollectAsSlice() {
@@ -66,3 +69,683 @@ func TestPipeline_CollectAsSlice(t *testing.T) {
 		})
 	}
 }
+
+func TestPipeline_CollectInto(t *testing.T) {
+	buffer := make([]int, 0, 8)
+	for _, batch := range [][]string{{"one", "three"}, {"seven"}} {
+		p := channels.NewPipeline[string, int](channels.FromSlice(batch), func(input <-chan string) <-chan int {
+			return channels.Map[string, int](input, func(element string) int {
+				return len(element)
+			})
+		})
+		buffer = p.CollectInto(buffer)
+	}
+	want := []int{5}
+	if !reflect.DeepEqual(buffer, want) {
+		t.Errorf("CollectInto() = %v, want %v", buffer, want)
+	}
+	if cap(buffer) != 8 {
+		t.Errorf("CollectInto() capacity = %d, want 8", cap(buffer))
+	}
+}
+
+func ExamplePipeline_WithMetrics() {
+	input := channels.FromSlice([]int{1, 2, 3, 4, 5})
+
+	pipeline := channels.NewPipeline[int, int](input, func(input <-chan int) <-chan int {
+		return channels.Filter(input, func(element int) bool {
+			return element%2 == 1
+		})
+	}).WithMetrics(channels.PipelineHooks{
+		OnStageComplete: func(stage string, count int) {
+			fmt.Printf("stage %v emitted %v elements\n", stage, count)
+		},
+	})
+
+	results := pipeline.CollectAsSlice()
+
+	fmt.Printf("Results: %v", results)
+	// Output:
+	// stage creation emitted 3 elements
+	// Results: [1 3 5]
+}
+
+func TestPipeline_WithMetrics(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         []string
+		wantResults   []int
+		wantProcessed map[string]int
+		wantCompleted map[string]int
+	}{
+		{
+			name:          "reports each element and the completion of the stage",
+			input:         []string{"one", "two", "three"},
+			wantResults:   []int{3, 3, 5},
+			wantProcessed: map[string]int{"creation": 3},
+			wantCompleted: map[string]int{"creation": 3},
+		},
+		{
+			name:          "empty input reports completion with a zero count",
+			input:         []string{},
+			wantResults:   nil,
+			wantProcessed: map[string]int{},
+			wantCompleted: map[string]int{"creation": 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			processed := map[string]int{}
+			completed := map[string]int{}
+			p := channels.NewPipeline[string, int](channels.FromSlice(tt.input), func(input <-chan string) <-chan int {
+				return channels.Map[string, int](input, func(element string) int {
+					return len(element)
+				})
+			}).WithMetrics(channels.PipelineHooks{
+				OnItemProcessed: func(stage string) {
+					processed[stage]++
+				},
+				OnStageComplete: func(stage string, count int) {
+					completed[stage] = count
+				},
+			})
+
+			got := p.CollectAsSlice()
+			if !reflect.DeepEqual(got, tt.wantResults) {
+				t.Errorf("CollectAsSlice() = %v, want %v", got, tt.wantResults)
+			}
+			if !reflect.DeepEqual(processed, tt.wantProcessed) {
+				t.Errorf("OnItemProcessed() counts = %v, want %v", processed, tt.wantProcessed)
+			}
+			if !reflect.DeepEqual(completed, tt.wantCompleted) {
+				t.Errorf("OnStageComplete() counts = %v, want %v", completed, tt.wantCompleted)
+			}
+		})
+	}
+}
+
+func TestPipeline_WithMetrics_MultipleStages(t *testing.T) {
+	processed := map[string]int{}
+	completed := map[string]int{}
+	input := make([]int, 1000)
+	for i := range input {
+		input[i] = i
+	}
+	p := channels.NewPipeline[int, int](channels.FromSlice(input), func(input <-chan int) <-chan int {
+		return input
+	}).WithMetrics(channels.PipelineHooks{
+		OnItemProcessed: func(stage string) {
+			processed[stage]++
+		},
+		OnStageComplete: func(stage string, count int) {
+			completed[stage] = count
+		},
+	}).Filter(func(element int) bool {
+		return element%2 == 0
+	}).DropWhile(func(element int) bool {
+		return element < 100
+	}).Scan(0, func(accumulator int, element int) int {
+		return element
+	})
+
+	got := p.Count()
+	want := map[string]int{"creation": 1000, "filter": 500, "dropWhile": 450, "scan": 450}
+	if got != 450 {
+		t.Errorf("Count() = %v, want %v", got, 450)
+	}
+	if !reflect.DeepEqual(processed, want) {
+		t.Errorf("OnItemProcessed() counts = %v, want %v", processed, want)
+	}
+	if !reflect.DeepEqual(completed, want) {
+		t.Errorf("OnStageComplete() counts = %v, want %v", completed, want)
+	}
+}
+
+func TestPipeline_WithMetrics_AfterStages(t *testing.T) {
+	completed := map[string]int{}
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3, 4}), func(input <-chan int) <-chan int {
+		return input
+	}).Filter(func(element int) bool {
+		return element > 1
+	}).WithMetrics(channels.PipelineHooks{
+		OnStageComplete: func(stage string, count int) {
+			completed[stage] = count
+		},
+	}).FilterNot(func(element int) bool {
+		return element == 3
+	})
+
+	p.CollectAsSlice()
+	want := map[string]int{"filter": 3, "filterNot": 2}
+	if !reflect.DeepEqual(completed, want) {
+		t.Errorf("OnStageComplete() counts = %v, want %v", completed, want)
+	}
+}
+
+func ExamplePipeline_FilterWithError() {
+	input := channels.FromSlice([]string{"1", "two", "3", "-4"})
+
+	pipeline := channels.NewPipeline[string, int](input, func(input <-chan string) <-chan int {
+		return channels.Map[string, int](input, func(element string) int {
+			n, _ := strconv.Atoi(element)
+			return n
+		})
+	}).FilterWithError(func(element int) (bool, error) {
+		if element == 0 {
+			return false, fmt.Errorf("not a number")
+		}
+		return element > 0, nil
+	})
+
+	results, errs := pipeline.CollectWithErrors()
+
+	fmt.Printf("Results: %v, errors: %v", results, errs)
+	// Output: Results: [1 3], errors: [0: not a number]
+}
+
+func TestPipeline_FilterWithError(t *testing.T) {
+	errInvalid := fmt.Errorf("invalid element")
+	tests := []struct {
+		name       string
+		input      []int
+		fn         channels.FilterWithErrorFunc[int]
+		want       []int
+		wantErrors []channels.ItemError[int]
+	}{
+		{
+			name:  "keeps matching elements and gathers errors",
+			input: []int{1, -2, 3, 4, 0},
+			fn: func(element int) (bool, error) {
+				if element < 0 {
+					return false, errInvalid
+				}
+				return element > 0, nil
+			},
+			want:       []int{1, 3, 4},
+			wantErrors: []channels.ItemError[int]{{Element: -2, Err: errInvalid}},
+		},
+		{
+			name:  "no errors provides nil errors",
+			input: []int{1, 2},
+			fn: func(element int) (bool, error) {
+				return true, nil
+			},
+			want:       []int{1, 2},
+			wantErrors: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := channels.NewPipeline[int, int](channels.FromSlice(tt.input), func(input <-chan int) <-chan int {
+				return input
+			}).FilterWithError(tt.fn)
+
+			got, gotErrors := p.CollectWithErrors()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CollectWithErrors() got = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotErrors, tt.wantErrors) {
+				t.Errorf("CollectWithErrors() errors = %v, want %v", gotErrors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestPipeline_FilterWithError_WithMetrics(t *testing.T) {
+	completed := map[string]int{}
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3}), func(input <-chan int) <-chan int {
+		return input
+	}).WithMetrics(channels.PipelineHooks{
+		OnStageComplete: func(stage string, count int) {
+			completed[stage] = count
+		},
+	}).FilterWithError(func(element int) (bool, error) {
+		return element != 2, nil
+	})
+
+	got := p.CollectAsSlice()
+	want := map[string]int{"creation": 3, "filterWithError": 2}
+	if !reflect.DeepEqual(got, []int{1, 3}) {
+		t.Errorf("CollectAsSlice() = %v, want %v", got, []int{1, 3})
+	}
+	if !reflect.DeepEqual(completed, want) {
+		t.Errorf("OnStageComplete() counts = %v, want %v", completed, want)
+	}
+}
+
+func ExamplePipeline_MapWithError() {
+	input := channels.FromSlice([]int{1, 0, 4})
+
+	pipeline := channels.NewPipeline[int, int](input, func(input <-chan int) <-chan int {
+		return input
+	}).MapWithError(func(element int) (int, error) {
+		if element == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return 100 / element, nil
+	})
+
+	results, errs := pipeline.CollectWithErrors()
+	for _, err := range errs {
+		fmt.Printf("failed element: %v, error: %v\n", err.Element, err.Err)
+	}
+
+	fmt.Printf("Results: %v", results)
+	// Output:
+	// failed element: 0, error: division by zero
+	// Results: [100 25]
+}
+
+func TestPipeline_MapWithError(t *testing.T) {
+	errInvalid := fmt.Errorf("invalid element")
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, -2, 3}), func(input <-chan int) <-chan int {
+		return input
+	}).MapWithError(func(element int) (int, error) {
+		if element < 0 {
+			return 0, errInvalid
+		}
+		return element * 10, nil
+	}).FilterWithError(func(element int) (bool, error) {
+		if element > 20 {
+			return false, errInvalid
+		}
+		return true, nil
+	})
+
+	got, gotErrors := p.CollectWithErrors()
+	want := []int{10}
+	wantErrors := []channels.ItemError[int]{{Element: -2, Err: errInvalid}, {Element: 30, Err: errInvalid}}
+	gotErrors = slices.SortByOrderedField(gotErrors, slices.AscendingSortFunc[int], func(e channels.ItemError[int]) int {
+		return e.Element
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CollectWithErrors() got = %v, want %v", got, want)
+	}
+	if !reflect.DeepEqual(gotErrors, wantErrors) {
+		t.Errorf("CollectWithErrors() errors = %v, want %v", gotErrors, wantErrors)
+	}
+}
+
+func TestPipeline_FilterFilterNot(t *testing.T) {
+	var isExpired slices.FindFunc[int] = func(days int) bool {
+		return days > 30
+	}
+	input := []int{5, 45, 12, 90}
+	identity := func(input <-chan int) <-chan int {
+		return input
+	}
+
+	kept := channels.NewPipeline[int, int](channels.FromSlice(input), identity).FilterNot(isExpired).CollectAsSlice()
+	if want := []int{5, 12}; !reflect.DeepEqual(kept, want) {
+		t.Errorf("FilterNot() = %v, want %v", kept, want)
+	}
+	dropped := channels.NewPipeline[int, int](channels.FromSlice(input), identity).Filter(isExpired).CollectAsSlice()
+	if want := []int{45, 90}; !reflect.DeepEqual(dropped, want) {
+		t.Errorf("Filter() = %v, want %v", dropped, want)
+	}
+}
+
+func TestPipeline_RateLimitBurst(t *testing.T) {
+	var stages []string
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3}), func(input <-chan int) <-chan int {
+		return input
+	}).WithMetrics(channels.PipelineHooks{
+		OnStageComplete: func(stage string, count int) {
+			stages = append(stages, stage+":"+strconv.Itoa(count))
+		},
+	}).RateLimitBurst(1000, 3)
+
+	got := p.CollectAsSlice()
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RateLimitBurst() = %v, want %v", got, want)
+	}
+	if !slices.Includes(stages, "rateLimitBurst:3") {
+		t.Errorf("RateLimitBurst() reported stages %v, want rateLimitBurst:3", stages)
+	}
+}
+
+func ExamplePipeline_RecoverPanics() {
+	input := channels.FromSlice([]string{"3", "", "5"})
+	p := channels.NewPipeline[string, string](input, func(input <-chan string) <-chan string {
+		return input
+	}).RecoverPanics(func(recovered any, element string) {
+		fmt.Printf("recovered %q: %v\n", element, recovered)
+	}).Filter(func(element string) bool {
+		return element[0] != '0'
+	})
+
+	fmt.Printf("results: %v", p.CollectAsSlice())
+	// Output:
+	// recovered "": runtime error: index out of range [0] with length 0
+	// results: [3 5]
+}
+
+func TestPipeline_RecoverPanics(t *testing.T) {
+	panicOnThree := func(element int) {
+		if element == 3 {
+			panic("three")
+		}
+	}
+	identity := func(input <-chan int) <-chan int {
+		return input
+	}
+	errFive := errors.New("five")
+	tests := []struct {
+		name       string
+		stage      func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int]
+		want       []int
+		wantErrors []error
+	}{
+		{
+			name: "filter skips the offending element",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.Filter(func(element int) bool {
+					panicOnThree(element)
+					return true
+				})
+			},
+			want: []int{1, 2, 4, 5},
+		},
+		{
+			name: "filterNot skips the offending element",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.FilterNot(func(element int) bool {
+					panicOnThree(element)
+					return false
+				})
+			},
+			want: []int{1, 2, 4, 5},
+		},
+		{
+			name: "dropWhile skips the offending element and keeps dropping",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.DropWhile(func(element int) bool {
+					panicOnThree(element)
+					return element < 4
+				})
+			},
+			want: []int{4, 5},
+		},
+		{
+			name: "takeWhile skips the offending element and keeps taking",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.TakeWhile(func(element int) bool {
+					panicOnThree(element)
+					return element < 5
+				})
+			},
+			want: []int{1, 2, 4},
+		},
+		{
+			name: "scan skips the offending element and keeps its accumulator",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.Scan(0, func(accumulator int, element int) int {
+					panicOnThree(element)
+					return accumulator + element
+				})
+			},
+			want: []int{1, 3, 7, 12},
+		},
+		{
+			name: "mapWithError skips the offending element without an error",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.MapWithError(func(element int) (int, error) {
+					panicOnThree(element)
+					if element == 5 {
+						return 0, errFive
+					}
+					return element * 10, nil
+				})
+			},
+			want:       []int{10, 20, 40},
+			wantErrors: []error{errFive},
+		},
+		{
+			name: "filterWithError skips the offending element without an error",
+			stage: func(p *channels.Pipeline[int, int]) *channels.Pipeline[int, int] {
+				return p.FilterWithError(func(element int) (bool, error) {
+					panicOnThree(element)
+					return element != 1, nil
+				})
+			},
+			want: []int{2, 4, 5},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recovered []int
+			p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3, 4, 5}), identity).
+				RecoverPanics(func(_ any, element int) {
+					recovered = append(recovered, element)
+				})
+
+			got, gotErrors := tt.stage(p).CollectWithErrors()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RecoverPanics() = %v, want %v", got, tt.want)
+			}
+			if plain := channels.PlainErrors(gotErrors); !reflect.DeepEqual(plain, tt.wantErrors) {
+				t.Errorf("RecoverPanics() errors = %v, want %v", plain, tt.wantErrors)
+			}
+			if !reflect.DeepEqual(recovered, []int{3}) {
+				t.Errorf("RecoverPanics() recovered %v, want [3]", recovered)
+			}
+		})
+	}
+}
+
+func ExamplePipeline_Count() {
+	events := channels.FromSlice([]string{"info", "error", "info", "error", "error"})
+	p := channels.NewPipeline[string, string](events, func(input <-chan string) <-chan string {
+		return input
+	}).Filter(func(level string) bool {
+		return level == "error"
+	})
+
+	fmt.Printf("errors: %v", p.Count())
+	// Output: errors: 3
+}
+
+func TestPipeline_MapStage(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    channels.MapOptions
+		recover bool
+		want    []int
+	}{
+		{
+			name: "default options map in order",
+			opts: channels.MapOptions{},
+			want: []int{2, 4, 6, 8, 10, 12},
+		},
+		{
+			name: "several workers preserving order map in order",
+			opts: channels.MapOptions{Workers: 4, PreserveOrder: true, BufferSize: 2},
+			want: []int{2, 4, 6, 8, 10, 12},
+		},
+		{
+			name:    "recovered panics drop the element",
+			opts:    channels.MapOptions{Workers: 3, PreserveOrder: true},
+			recover: true,
+			want:    []int{2, 4, 8, 10, 12},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stages []string
+			p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3, 4, 5, 6}), func(input <-chan int) <-chan int {
+				return input
+			}).WithMetrics(channels.PipelineHooks{
+				OnStageComplete: func(stage string, count int) {
+					stages = append(stages, stage+":"+strconv.Itoa(count))
+				},
+			})
+			var recovered []int
+			if tt.recover {
+				p = p.RecoverPanics(func(r any, element int) {
+					recovered = append(recovered, element)
+				})
+			}
+			p = p.MapStage(tt.opts, func(element int) int {
+				if tt.recover && element == 3 {
+					panic("three")
+				}
+				return element * 2
+			})
+
+			got := p.CollectAsSlice()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapStage() = %v, want %v", got, tt.want)
+			}
+			if want := "mapStage:" + strconv.Itoa(len(tt.want)); !slices.Includes(stages, want) {
+				t.Errorf("MapStage() reported stages %v, want %v", stages, want)
+			}
+			if tt.recover && !reflect.DeepEqual(recovered, []int{3}) {
+				t.Errorf("MapStage() recovered %v, want [3]", recovered)
+			}
+		})
+	}
+}
+
+func TestPipeline_Scan(t *testing.T) {
+	var stages []string
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3}), func(input <-chan int) <-chan int {
+		return input
+	}).WithMetrics(channels.PipelineHooks{
+		OnStageComplete: func(stage string, count int) {
+			stages = append(stages, stage+":"+strconv.Itoa(count))
+		},
+	}).Scan(10, func(accumulator int, element int) int {
+		return accumulator + element
+	})
+
+	got := p.CollectAsSlice()
+	want := []int{11, 13, 16}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan() = %v, want %v", got, want)
+	}
+	if !slices.Includes(stages, "scan:3") {
+		t.Errorf("Scan() reported stages %v, want scan:3", stages)
+	}
+}
+
+func TestPipeline_TakeWhileDropWhile(t *testing.T) {
+	p := channels.NewPipeline[int, int](channels.FromSlice([]int{1, 2, 3, 4, 5, 6, 1}), func(input <-chan int) <-chan int {
+		return input
+	}).DropWhile(func(element int) bool {
+		return element < 3
+	}).TakeWhile(func(element int) bool {
+		return element < 6
+	})
+
+	got := p.CollectAsSlice()
+	want := []int{3, 4, 5}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DropWhile().TakeWhile() = %v, want %v", got, want)
+	}
+}
+
+func TestPipeline_TakeWhile_StopsUnboundedUpstream(t *testing.T) {
+	tests := []struct {
+		name    string
+		recover bool
+	}{
+		{name: "without recovery"},
+		{name: "with recovery", recover: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := make(chan int)
+			filterDone := make(chan struct{})
+			p := channels.NewPipeline[int, int](input, func(input <-chan int) <-chan int {
+				return channels.Map(input, func(element int) int {
+					return element * 10
+				})
+			}).WithMetrics(channels.PipelineHooks{
+				OnStageComplete: func(stage string, count int) {
+					if stage == "filter" {
+						close(filterDone)
+					}
+				},
+			})
+			if tt.recover {
+				p = p.RecoverPanics(func(recovered any, element int) {})
+			}
+			p = p.Filter(func(element int) bool {
+				return element%20 == 0
+			}).TakeWhile(func(element int) bool {
+				return element < 100
+			})
+
+			senderDone := make(chan struct{})
+			go func() {
+				defer close(senderDone)
+				defer close(input)
+				for i := 0; ; i++ {
+					select {
+					case input <- i:
+					case <-p.Done():
+						return
+					}
+				}
+			}()
+
+			got := p.CollectAsSlice()
+			want := []int{0, 20, 40, 60, 80}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("TakeWhile() = %v, want %v", got, want)
+			}
+			for name, done := range map[string]chan struct{}{"sender": senderDone, "filter stage": filterDone} {
+				select {
+				case <-done:
+				case <-time.After(5 * time.Second):
+					t.Fatalf("TakeWhile() did not stop the %v", name)
+				}
+			}
+		})
+	}
+}
+
+func TestPipeline_DedupeWindow(t *testing.T) {
+	var recovered []alert
+	p := channels.NewPipeline[alert, alert](channels.FromSlice([]alert{
+		{Name: "disk", Severity: 1},
+		{Name: "", Severity: 9},
+		{Name: "disk", Severity: 2},
+		{Name: "cpu", Severity: 1},
+	}), func(input <-chan alert) <-chan alert {
+		return input
+	}).RecoverPanics(func(_ any, element alert) {
+		recovered = append(recovered, element)
+	}).DedupeWindow(time.Minute, func(element alert) any {
+		if element.Name == "" {
+			panic("unnamed alert")
+		}
+		return element.Name
+	})
+
+	got := p.CollectAsSlice()
+	want := []alert{{Name: "disk", Severity: 1}, {Name: "cpu", Severity: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DedupeWindow() = %v, want %v", got, want)
+	}
+	if wantRecovered := []alert{{Name: "", Severity: 9}}; !reflect.DeepEqual(recovered, wantRecovered) {
+		t.Errorf("DedupeWindow() recovered %v, want %v", recovered, wantRecovered)
+	}
+}
+
+func TestPipeline_CollectSorted(t *testing.T) {
+	p := channels.NewPipeline[string, int](channels.FromSlice([]string{"three", "one", "four"}), func(input <-chan string) <-chan int {
+		return channels.MapStage(input, channels.MapOptions{Workers: 3}, func(element string) int {
+			return len(element)
+		})
+	})
+
+	got := p.CollectSorted(func(a, b int) bool {
+		return a > b
+	})
+	want := []int{5, 4, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CollectSorted() = %v, want %v", got, want)
+	}
+}
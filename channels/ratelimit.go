@@ -0,0 +1,49 @@
+package channels
+
+import "time"
+
+// RateLimitBurst reads all elements from the input channel and writes them to the output channel at a sustained rate
+// of at most ratePerSec elements per second, using a token bucket.  The bucket holds up to burst tokens and starts
+// full, so a burst of up to that many elements passes immediately, after which tokens are refilled continuously at the
+// given rate.  When no token is available, the stage waits for one before reading the next element, applying
+// backpressure to the earlier stages.  A burst of zero or less is treated as one, and a rate of zero or less disables
+// the limit.  The output channel is closed once the input channel is closed.
+func RateLimitBurst[T any](input <-chan T, ratePerSec float64, burst int) <-chan T {
+	return rateLimitBurst(input, ratePerSec, burst, wallClock{})
+}
+
+// rateLimitBurst implements RateLimitBurst, reading the time from and waiting on the given clock.
+func rateLimitBurst[T any](input <-chan T, ratePerSec float64, burst int, clock clock) <-chan T {
+	if burst <= 0 {
+		burst = 1
+	}
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		if ratePerSec <= 0 {
+			for element := range input {
+				output <- element
+			}
+			return
+		}
+		tokens := float64(burst)
+		last := clock.Now()
+		for element := range input {
+			now := clock.Now()
+			tokens += now.Sub(last).Seconds() * ratePerSec
+			if tokens > float64(burst) {
+				tokens = float64(burst)
+			}
+			last = now
+			if tokens < 1 {
+				wait := time.Duration((1 - tokens) / ratePerSec * float64(time.Second))
+				clock.Sleep(wait)
+				tokens = 1
+				last = clock.Now()
+			}
+			tokens--
+			output <- element
+		}
+	}()
+	return output
+}
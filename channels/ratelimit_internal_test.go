@@ -0,0 +1,53 @@
+package channels
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRateLimitBurst_Clock(t *testing.T) {
+	tests := []struct {
+		name       string
+		ratePerSec float64
+		burst      int
+		steps      []time.Duration
+		wantSlept  []time.Duration
+	}{
+		{
+			name:       "burst passes immediately, then each element waits for a token",
+			ratePerSec: 50,
+			burst:      5,
+			wantSlept:  []time.Duration{20 * time.Millisecond, 20 * time.Millisecond, 20 * time.Millisecond},
+		},
+		{
+			name:       "tokens refill while the input is idle",
+			ratePerSec: 10,
+			burst:      2,
+			// The clock is read once when the stage starts, then for each element - the third arrives a second later,
+			// refilling the bucket, so only the last four of the eight elements wait.
+			steps:     []time.Duration{0, 0, 0, time.Second},
+			wantSlept: []time.Duration{100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+		},
+		{
+			name:       "zero burst is treated as one",
+			ratePerSec: 4,
+			burst:      0,
+			wantSlept:  []time.Duration{250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond, 250 * time.Millisecond},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := []int{1, 2, 3, 4, 5, 6, 7, 8}
+			clock := &fakeClock{steps: tt.steps}
+
+			got := CollectAsSlice(rateLimitBurst(FromSlice(input), tt.ratePerSec, tt.burst, clock))
+			if !reflect.DeepEqual(got, input) {
+				t.Errorf("rateLimitBurst() = %v, want %v", got, input)
+			}
+			if slept := clock.sleeps(); !reflect.DeepEqual(slept, tt.wantSlept) {
+				t.Errorf("rateLimitBurst() slept %v, want %v", slept, tt.wantSlept)
+			}
+		})
+	}
+}
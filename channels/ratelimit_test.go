@@ -0,0 +1,36 @@
+package channels_test
+
+import (
+	"github.com/pickeringtech/go-collections/channels"
+	"reflect"
+	"testing"
+)
+
+func TestRateLimitBurst_NoLimit(t *testing.T) {
+	tests := []struct {
+		name       string
+		ratePerSec float64
+		burst      int
+	}{
+		{
+			name:       "zero rate disables the limit",
+			ratePerSec: 0,
+			burst:      1,
+		},
+		{
+			name:       "negative rate disables the limit",
+			ratePerSec: -1,
+			burst:      0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := channels.FromSlice([]int{1, 2, 3})
+			got := channels.CollectAsSlice(channels.RateLimitBurst(input, tt.ratePerSec, tt.burst))
+			want := []int{1, 2, 3}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("RateLimitBurst() = %v, want %v", got, want)
+			}
+		})
+	}
+}
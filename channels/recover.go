@@ -0,0 +1,90 @@
+package channels
+
+import "errors"
+
+// errRecovered stands in for the error of a function which panicked, so that the element which caused the panic can be
+// dropped by a stage which reports errors, and the error then removed before it is gathered.
+var errRecovered = errors.New("channels: recovered from panic")
+
+// recoverEach reads each element of the input channel and calls the step function with it, writing the result to the
+// output channel when the step function reports it should be emitted.  A panic within the step function is recovered
+// and reported to the handler along with the element, which is then skipped.  Once the step function reports that the
+// stage is done, the output channel is closed and the rest of the input is read and discarded in the background.
+func recoverEach[T any](input <-chan T, handler func(recovered any, element T), step func(element T) (result T, emit bool, done bool)) <-chan T {
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		for element := range input {
+			result, emit, done := recoverStep(element, handler, step)
+			if done {
+				go discard(input)
+				return
+			}
+			if emit {
+				output <- result
+			}
+		}
+	}()
+	return output
+}
+
+// recoverStep calls the step function with the element, recovering from a panic within it by reporting it to the
+// handler and skipping the element.
+func recoverStep[T any](element T, handler func(recovered any, element T), step func(element T) (T, bool, bool)) (result T, emit bool, done bool) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			handler(recovered, element)
+			emit, done = false, false
+		}
+	}()
+	return step(element)
+}
+
+// recoverErrors wraps the given function so that a panic within it is recovered, reported to the handler along with
+// the element, and returned as errRecovered.
+func recoverErrors[T, R any](handler func(recovered any, element T), fn func(element T) (R, error)) func(element T) (R, error) {
+	return func(element T) (result R, err error) {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handler(recovered, element)
+				err = errRecovered
+			}
+		}()
+		return fn(element)
+	}
+}
+
+// recoveredResult is the result of a mapping function which may have panicked, in which case ok is false.
+type recoveredResult[T any] struct {
+	value T
+	ok    bool
+}
+
+// recoverMap transforms each element of the input channel using MapStage, recovering from a panic within the function
+// by reporting it to the handler and dropping the element.
+func recoverMap[T any](input <-chan T, opts MapOptions, handler func(recovered any, element T), fn MapFunc[T, T]) <-chan T {
+	mapFn := recoverErrors(handler, func(element T) (T, error) {
+		return fn(element), nil
+	})
+	results := MapStage(input, opts, func(element T) recoveredResult[T] {
+		value, err := mapFn(element)
+		return recoveredResult[T]{value: value, ok: err == nil}
+	})
+	output := make(chan T)
+	go func() {
+		defer close(output)
+		for result := range results {
+			if result.ok {
+				output <- result.value
+			}
+		}
+	}()
+	return output
+}
+
+// withoutRecovered removes the errors standing in for recovered panics from the given channel of errors.
+func withoutRecovered[T any](errs <-chan ItemError[T]) <-chan ItemError[T] {
+	return Filter(errs, func(element ItemError[T]) bool {
+		return element.Err != errRecovered
+	})
+}
@@ -18,3 +18,82 @@ func Filter[T any](input <-chan T, fn FilterFunc[T]) <-chan T {
 	}()
 	return output
 }
+
+// FilterNot reads all elements from the input channel and writes them to the output channel if the given FilterFunc
+// returns false for that element, so that a single predicate can be used to both keep and drop elements.  The output
+// channel is closed once the input channel is closed.
+func FilterNot[T any](input <-chan T, fn FilterFunc[T]) <-chan T {
+	return Filter(input, func(element T) bool {
+		return !fn(element)
+	})
+}
+
+// FilterWithErrorFunc is a function which takes an input element and returns true if the element should be included in
+// the output channel.  If an error is returned, the element is excluded regardless of the boolean result.
+type FilterWithErrorFunc[T any] func(element T) (bool, error)
+
+// FilterWithError reads all elements from the input channel and writes them to the output channel if the given
+// FilterWithErrorFunc returns true for that element.  Any error returned by the FilterWithErrorFunc is written to the
+// error channel, and the element which caused it is dropped.  Both channels are closed once the input channel is
+// closed, and both must be read concurrently, as writing to either blocks until it is read.
+func FilterWithError[T any](input <-chan T, fn FilterWithErrorFunc[T]) (<-chan T, <-chan ItemError[T]) {
+	output := make(chan T)
+	errors := make(chan ItemError[T])
+	go func() {
+		for element := range input {
+			keep, err := fn(element)
+			if err != nil {
+				errors <- ItemError[T]{Element: element, Err: err}
+				continue
+			}
+			if keep {
+				output <- element
+			}
+		}
+		close(output)
+		close(errors)
+	}()
+	return output, errors
+}
+
+// TakeWhile reads elements from the input channel and writes them to the output channel for as long as the given
+// FilterFunc returns true.  At the first element for which it returns false, that element is dropped and the output
+// channel is closed.  The stopped channel is closed once no more elements are needed from the input, either for that
+// reason or because the input was closed.  A sender of an unbounded input should watch the stopped channel, and stop
+// producing and close the input once it is closed - until then, the rest of the input is read and discarded in the
+// background, so that neither the sender nor any stages between it and TakeWhile are left blocked.
+func TakeWhile[T any](input <-chan T, fn FilterFunc[T]) (output <-chan T, stopped <-chan struct{}) {
+	results := make(chan T)
+	stop := make(chan struct{})
+	go func() {
+		defer close(results)
+		defer close(stop)
+		for element := range input {
+			if !fn(element) {
+				go discard(input)
+				return
+			}
+			results <- element
+		}
+	}()
+	return results, stop
+}
+
+// DropWhile reads elements from the input channel, discarding them for as long as the given FilterFunc returns true.
+// From the first element for which it returns false onwards, every element is written to the output channel, without
+// calling the FilterFunc again.  The output channel is closed once the input channel is closed.
+func DropWhile[T any](input <-chan T, fn FilterFunc[T]) <-chan T {
+	output := make(chan T)
+	go func() {
+		dropping := true
+		for element := range input {
+			if dropping && fn(element) {
+				continue
+			}
+			dropping = false
+			output <- element
+		}
+		close(output)
+	}()
+	return output
+}
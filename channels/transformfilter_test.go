@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/channels"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 )
 
 func ExampleFilter() {
@@ -73,3 +76,302 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterNot(t *testing.T) {
+	longerThanFive := func(element string) bool {
+		return len(element) > 5
+	}
+	type testCase[T any] struct {
+		name  string
+		input <-chan T
+		want  []T
+	}
+	tests := []testCase[string]{
+		{
+			name:  "keeps words the function rejects",
+			input: channels.FromSlice([]string{"hello", "everyone", "world", "goodness"}),
+			want:  []string{"hello", "world"},
+		},
+		{
+			name:  "nil input provides nil output",
+			input: channels.FromSlice[string](nil),
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectAsSlice(channels.FilterNot(tt.input, longerThanFive))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterNot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFilterWithError() {
+	input := channels.FromSlice([]string{"1", "two", "3", "-4"})
+	output, errors := channels.FilterWithError(input, func(element string) (bool, error) {
+		n, err := strconv.Atoi(element)
+		if err != nil {
+			return false, err
+		}
+		return n > 0, nil
+	})
+
+	// Errors must be read at the same time as the output.
+	var errs []channels.ItemError[string]
+	done := make(chan struct{})
+	go func() {
+		errs = channels.CollectAsSlice(errors)
+		close(done)
+	}()
+	results := channels.CollectAsSlice(output)
+	<-done
+
+	fmt.Printf("Results: %v, errors: %v", results, len(errs))
+	// Output: Results: [1 3], errors: 1
+}
+
+func TestFilterWithError(t *testing.T) {
+	errInvalid := fmt.Errorf("invalid element")
+	type args[T any] struct {
+		input <-chan T
+		fn    channels.FilterWithErrorFunc[T]
+	}
+	type testCase[T any] struct {
+		name       string
+		args       args[T]
+		want       []T
+		wantErrors []channels.ItemError[T]
+	}
+	tests := []testCase[int]{
+		{
+			name: "keeps matching elements and reports errors",
+			args: args[int]{
+				input: channels.FromSlice([]int{1, -2, 3, 4, 0}),
+				fn: func(element int) (bool, error) {
+					if element < 0 {
+						return false, errInvalid
+					}
+					return element > 0, nil
+				},
+			},
+			want:       []int{1, 3, 4},
+			wantErrors: []channels.ItemError[int]{{Element: -2, Err: errInvalid}},
+		},
+		{
+			name: "elements causing errors are dropped even if matched",
+			args: args[int]{
+				input: channels.FromSlice([]int{1, 2}),
+				fn: func(element int) (bool, error) {
+					return true, errInvalid
+				},
+			},
+			want:       nil,
+			wantErrors: []channels.ItemError[int]{{Element: 1, Err: errInvalid}, {Element: 2, Err: errInvalid}},
+		},
+		{
+			name: "empty input provides nil output and no errors",
+			args: args[int]{
+				input: channels.FromSlice([]int{}),
+				fn: func(element int) (bool, error) {
+					return true, nil
+				},
+			},
+			want:       nil,
+			wantErrors: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, errors := channels.FilterWithError(tt.args.input, tt.args.fn)
+			var gotErrors []channels.ItemError[int]
+			done := make(chan struct{})
+			go func() {
+				gotErrors = channels.CollectAsSlice(errors)
+				close(done)
+			}()
+			got := channels.CollectAsSlice(output)
+			<-done
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterWithError() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotErrors, tt.wantErrors) {
+				t.Errorf("FilterWithError() errors = %v, want %v", gotErrors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func ExampleTakeWhile() {
+	timestamps := channels.FromSlice([]int{100, 105, 110, 120, 130})
+
+	// Stop as soon as the cutoff is exceeded.
+	output, _ := channels.TakeWhile(timestamps, func(element int) bool {
+		return element <= 110
+	})
+
+	fmt.Printf("Results: %v", channels.CollectAsSlice(output))
+	// Output: Results: [100 105 110]
+}
+
+func TestTakeWhile(t *testing.T) {
+	lessThanThree := func(element int) bool {
+		return element < 3
+	}
+	type args[T any] struct {
+		input []T
+		fn    channels.FilterFunc[T]
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "takes elements until the first non-match",
+			args: args[int]{
+				input: []int{1, 2, 3, 1, 2},
+				fn:    lessThanThree,
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "takes every element when all match",
+			args: args[int]{
+				input: []int{1, 2},
+				fn:    lessThanThree,
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "first element not matching produces nil output",
+			args: args[int]{
+				input: []int{5, 1},
+				fn:    lessThanThree,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, _ := channels.TakeWhile(channels.FromSlice(tt.args.input), tt.args.fn)
+			got := channels.CollectAsSlice(output)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TakeWhile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTakeWhile_StopsUnboundedUpstream(t *testing.T) {
+	input := make(chan int)
+	evens := channels.Filter(input, func(element int) bool {
+		return element%2 == 0
+	})
+	// Forward the Filter output so its completion can be observed without competing with TakeWhile for elements.
+	filtered := make(chan int)
+	filterDone := make(chan struct{})
+	go func() {
+		defer close(filterDone)
+		defer close(filtered)
+		for element := range evens {
+			filtered <- element
+		}
+	}()
+	output, stopped := channels.TakeWhile(filtered, func(element int) bool {
+		return element < 10
+	})
+
+	senderDone := make(chan struct{})
+	go func() {
+		defer close(senderDone)
+		defer close(input)
+		for i := 0; ; i++ {
+			select {
+			case input <- i:
+			case <-stopped:
+				return
+			}
+		}
+	}()
+
+	got := channels.CollectAsSlice(output)
+	want := []int{0, 2, 4, 6, 8}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhile() = %v, want %v", got, want)
+	}
+	select {
+	case <-senderDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("TakeWhile() did not stop the unbounded sender")
+	}
+	// With the input closed, the stage between the sender and TakeWhile finishes too.
+	select {
+	case <-filterDone:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Filter() did not finish after TakeWhile() stopped")
+	}
+}
+
+func ExampleDropWhile() {
+	lines := channels.FromSlice([]string{"# header", "# comment", "data 1", "# inline", "data 2"})
+
+	// Skip the leading comment block.
+	output := channels.DropWhile(lines, func(element string) bool {
+		return strings.HasPrefix(element, "#")
+	})
+
+	fmt.Printf("Results: %v", channels.CollectAsSlice(output))
+	// Output: Results: [data 1 # inline data 2]
+}
+
+func TestDropWhile(t *testing.T) {
+	lessThanThree := func(element int) bool {
+		return element < 3
+	}
+	type args[T any] struct {
+		input []T
+		fn    channels.FilterFunc[T]
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "drops the leading run, keeping everything after",
+			args: args[int]{
+				input: []int{1, 2, 3, 1, 2},
+				fn:    lessThanThree,
+			},
+			want: []int{3, 1, 2},
+		},
+		{
+			name: "drops every element when all match",
+			args: args[int]{
+				input: []int{1, 2},
+				fn:    lessThanThree,
+			},
+			want: nil,
+		},
+		{
+			name: "first element not matching keeps everything",
+			args: args[int]{
+				input: []int{5, 1},
+				fn:    lessThanThree,
+			},
+			want: []int{5, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectAsSlice(channels.DropWhile(channels.FromSlice(tt.args.input), tt.args.fn))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DropWhile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
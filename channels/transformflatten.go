@@ -0,0 +1,16 @@
+package channels
+
+// Flatten reads each slice from the input channel and writes every element of that slice to the output channel, in
+// order.  A nil or empty slice contributes no elements.  The output channel is closed once the input channel is closed.
+func Flatten[T any](input <-chan []T) <-chan T {
+	output := make(chan T)
+	go func() {
+		for batch := range input {
+			for _, element := range batch {
+				output <- element
+			}
+		}
+		close(output)
+	}()
+	return output
+}
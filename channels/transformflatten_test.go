@@ -0,0 +1,62 @@
+package channels_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/channels"
+	"reflect"
+	"testing"
+)
+
+func ExampleFlatten() {
+	batches := channels.FromSlice([][]int{{1, 2}, {3}, {4, 5, 6}})
+	output := channels.Flatten(batches)
+
+	// Capture results in a slice.
+	results := channels.CollectAsSlice(output)
+
+	// Print results.
+	fmt.Printf("Results: %v", results)
+	// Output: Results: [1 2 3 4 5 6]
+}
+
+func TestFlatten(t *testing.T) {
+	type args[T any] struct {
+		input <-chan []T
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[string]{
+		{
+			name: "emits every element of every slice in order",
+			args: args[string]{
+				input: channels.FromSlice([][]string{{"a", "b"}, {"c"}, {"d", "e"}}),
+			},
+			want: []string{"a", "b", "c", "d", "e"},
+		},
+		{
+			name: "nil and empty slices contribute nothing",
+			args: args[string]{
+				input: channels.FromSlice([][]string{nil, {"a"}, {}, {"b"}, nil}),
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "empty input produces nil output",
+			args: args[string]{
+				input: channels.FromSlice([][]string{}),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectAsSlice(channels.Flatten(tt.args.input))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Flatten() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package channels
 
+import "sync"
+
 type MapFunc[I, O any] func(I) O
 
 // Map takes an input channel, transforms each of its entries using the MapFunc until the input channel is closed.
@@ -15,3 +17,112 @@ func Map[I, O any](input <-chan I, fn MapFunc[I, O]) chan O {
 	}()
 	return output
 }
+
+// MapOptions configures how MapStage runs its mapping function.
+type MapOptions struct {
+	// Workers is the number of goroutines which run the mapping function concurrently.  Defaults to 1.
+	Workers int
+	// PreserveOrder ensures that the results are written to the output channel in the same order as their inputs were
+	// read, when there is more than one worker.  To do so, up to Workers + BufferSize results are held in memory while
+	// waiting for any earlier, slower results to complete.  Defaults to false.
+	PreserveOrder bool
+	// BufferSize is the capacity of the output channel.  Defaults to 0, an unbuffered channel.
+	BufferSize int
+}
+
+// MapStage takes an input channel, transforms each of its entries using the MapFunc until the input channel is closed,
+// writing the results to the returned output channel.  The options control how many workers run the MapFunc
+// concurrently, whether the order of the input is preserved, and the buffer size of the output.  With the default
+// options, MapStage behaves the same as Map.  The output channel is closed once every result has been written.
+func MapStage[I, O any](input <-chan I, opts MapOptions, fn MapFunc[I, O]) <-chan O {
+	if opts.Workers <= 0 {
+		opts.Workers = 1
+	}
+	if opts.BufferSize < 0 {
+		opts.BufferSize = 0
+	}
+	output := make(chan O, opts.BufferSize)
+	if opts.PreserveOrder && opts.Workers > 1 {
+		go mapOrdered(input, output, opts, fn)
+		return output
+	}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for val := range input {
+				output <- fn(val)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(output)
+	}()
+	return output
+}
+
+// mapJob is an element waiting to be mapped, along with the channel its result must be written to.
+type mapJob[I, O any] struct {
+	element I
+	result  chan O
+}
+
+// mapOrdered maps the input using several workers, writing the results to the output in input order.  Each element is
+// given its own result channel, queued in input order, which bounds the number of results in flight to the capacity of
+// the queue.
+func mapOrdered[I, O any](input <-chan I, output chan<- O, opts MapOptions, fn MapFunc[I, O]) {
+	jobs := make(chan mapJob[I, O])
+	queue := make(chan chan O, opts.Workers+opts.BufferSize)
+
+	go func() {
+		for element := range input {
+			result := make(chan O, 1)
+			queue <- result
+			jobs <- mapJob[I, O]{element: element, result: result}
+		}
+		close(jobs)
+		close(queue)
+	}()
+
+	for i := 0; i < opts.Workers; i++ {
+		go func() {
+			for job := range jobs {
+				job.result <- fn(job.element)
+			}
+		}()
+	}
+
+	for result := range queue {
+		output <- <-result
+	}
+	close(output)
+}
+
+// MapWithErrorFunc is a function which transforms an input element, or reports an error if it cannot.
+type MapWithErrorFunc[I, O any] func(I) (O, error)
+
+// MapWithError takes an input channel, transforms each of its entries using the MapWithErrorFunc until the input
+// channel is closed, writing the results to the output channel.  Any error returned by the MapWithErrorFunc is written
+// to the error channel as an ItemError holding the element which caused it, and no result is written for that element.
+// Both channels are closed once the input channel is closed, and both must be read concurrently, as writing to either
+// blocks until it is read.
+func MapWithError[I, O any](input <-chan I, fn MapWithErrorFunc[I, O]) (<-chan O, <-chan ItemError[I]) {
+	output := make(chan O)
+	errors := make(chan ItemError[I])
+	go func() {
+		for val := range input {
+			res, err := fn(val)
+			if err != nil {
+				errors <- ItemError[I]{Element: val, Err: err}
+				continue
+			}
+			output <- res
+		}
+		close(output)
+		close(errors)
+	}()
+	return output, errors
+}
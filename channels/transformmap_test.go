@@ -3,8 +3,11 @@ package channels_test
 import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/channels"
+	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 )
 
 func ExampleMap() {
@@ -73,3 +76,171 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func ExampleMapStage() {
+	input := channels.FromSlice([]int{1, 2, 3, 4, 5})
+	output := channels.MapStage(input, channels.MapOptions{
+		Workers:       3,
+		PreserveOrder: true,
+	}, func(element int) string {
+		return strconv.Itoa(element * 10)
+	})
+
+	// Capture results in a slice.
+	results := channels.CollectAsSlice(output)
+
+	// Print results.
+	fmt.Printf("Results: %v", results)
+	// Output: Results: [10 20 30 40 50]
+}
+
+func TestMapStage(t *testing.T) {
+	// slowFirst delays the smallest elements the longest, so that concurrent workers finish out of order.
+	slowFirst := func(element int) int {
+		time.Sleep(time.Duration(10-element) * time.Millisecond)
+		return element * 2
+	}
+	type args[I any, O any] struct {
+		input []I
+		opts  channels.MapOptions
+		fn    channels.MapFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name        string
+		args        args[I, O]
+		want        []O
+		wantOrdered bool
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "default options map in order",
+			args: args[int, int]{
+				input: []int{1, 2, 3, 4, 5},
+				opts:  channels.MapOptions{},
+				fn:    slowFirst,
+			},
+			want:        []int{2, 4, 6, 8, 10},
+			wantOrdered: true,
+		},
+		{
+			name: "many workers preserving order map in order",
+			args: args[int, int]{
+				input: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				opts:  channels.MapOptions{Workers: 4, PreserveOrder: true, BufferSize: 2},
+				fn:    slowFirst,
+			},
+			want:        []int{2, 4, 6, 8, 10, 12, 14, 16, 18},
+			wantOrdered: true,
+		},
+		{
+			name: "many workers without preserving order map every element",
+			args: args[int, int]{
+				input: []int{1, 2, 3, 4, 5, 6, 7, 8, 9},
+				opts:  channels.MapOptions{Workers: 4},
+				fn:    slowFirst,
+			},
+			want:        []int{2, 4, 6, 8, 10, 12, 14, 16, 18},
+			wantOrdered: false,
+		},
+		{
+			name: "empty input provides nil output",
+			args: args[int, int]{
+				input: []int{},
+				opts:  channels.MapOptions{Workers: 4, PreserveOrder: true},
+				fn:    slowFirst,
+			},
+			want:        nil,
+			wantOrdered: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output := channels.MapStage(channels.FromSlice(tt.args.input), tt.args.opts, tt.args.fn)
+			got := channels.CollectAsSlice(output)
+			if !tt.wantOrdered {
+				got = slices.SortOrderedAsc(got)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapStage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleMapWithError() {
+	input := channels.FromSlice([]string{"1", "two", "3"})
+	output, errors := channels.MapWithError(input, strconv.Atoi)
+
+	// Errors must be read at the same time as the output.
+	var errs []channels.ItemError[string]
+	done := make(chan struct{})
+	go func() {
+		errs = channels.CollectAsSlice(errors)
+		close(done)
+	}()
+	results := channels.CollectAsSlice(output)
+	<-done
+
+	fmt.Printf("Results: %v, failed: %v", results, errs[0].Element)
+	// Output: Results: [1 3], failed: two
+}
+
+func TestMapWithError(t *testing.T) {
+	errNegative := fmt.Errorf("negative")
+	type args[I any, O any] struct {
+		input <-chan I
+		fn    channels.MapWithErrorFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name       string
+		args       args[I, O]
+		want       []O
+		wantErrors []channels.ItemError[I]
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "maps elements and reports errors with their element",
+			args: args[int, string]{
+				input: channels.FromSlice([]int{1, -2, 3}),
+				fn: func(element int) (string, error) {
+					if element < 0 {
+						return "", errNegative
+					}
+					return strconv.Itoa(element), nil
+				},
+			},
+			want:       []string{"1", "3"},
+			wantErrors: []channels.ItemError[int]{{Element: -2, Err: errNegative}},
+		},
+		{
+			name: "empty input provides nil output and no errors",
+			args: args[int, string]{
+				input: channels.FromSlice([]int{}),
+				fn: func(element int) (string, error) {
+					return strconv.Itoa(element), nil
+				},
+			},
+			want:       nil,
+			wantErrors: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, errors := channels.MapWithError(tt.args.input, tt.args.fn)
+			var gotErrors []channels.ItemError[int]
+			done := make(chan struct{})
+			go func() {
+				gotErrors = channels.CollectAsSlice(errors)
+				close(done)
+			}()
+			got := channels.CollectAsSlice(output)
+			<-done
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapWithError() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(gotErrors, tt.wantErrors) {
+				t.Errorf("MapWithError() errors = %v, want %v", gotErrors, tt.wantErrors)
+			}
+		})
+	}
+}
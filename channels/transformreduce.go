@@ -16,3 +16,21 @@ func Reduce[I, O any](input <-chan I, fn ReduceFunc[I, O]) <-chan O {
 	}()
 	return output
 }
+
+// Scan reads all elements from the input channel, applying the given ReduceFunc to each one, starting from the initial
+// accumulator value, and writes the accumulator to the output channel after every element.  This is the streaming
+// counterpart of Reduce, providing each running value rather than only the final one - the first value written is the
+// result of applying the ReduceFunc to the initial value and the first element.  The output channel is closed once the
+// input channel is closed, without writing anything if the input was empty.
+func Scan[I, O any](input <-chan I, initial O, fn ReduceFunc[I, O]) <-chan O {
+	output := make(chan O)
+	go func() {
+		accumulator := initial
+		for element := range input {
+			accumulator = fn(accumulator, element)
+			output <- accumulator
+		}
+		close(output)
+	}()
+	return output
+}
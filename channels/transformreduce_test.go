@@ -62,3 +62,68 @@ func TestReduce(t *testing.T) {
 		})
 	}
 }
+
+func ExampleScan() {
+	type event struct {
+		name string
+	}
+	input := channels.FromSlice([]event{{"login"}, {"click"}, {"logout"}})
+
+	// Emits the cumulative number of events as each one arrives.
+	counts := channels.Scan(input, 0, func(accumulator int, element event) int {
+		return accumulator + 1
+	})
+
+	fmt.Printf("Results: %v", channels.CollectAsSlice(counts))
+	// Output: Results: [1 2 3]
+}
+
+func TestScan(t *testing.T) {
+	type args[I any, O any] struct {
+		input   <-chan I
+		initial O
+		fn      channels.ReduceFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name string
+		args args[I, O]
+		want []O
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "emits each running total",
+			args: args[int, int]{
+				input:   channels.FromSlice([]int{1, 2, 3, 4}),
+				initial: 0,
+				fn:      func(a int, b int) int { return a + b },
+			},
+			want: []int{1, 3, 6, 10},
+		},
+		{
+			name: "first value includes the initial value",
+			args: args[int, int]{
+				input:   channels.FromSlice([]int{1, 2}),
+				initial: 100,
+				fn:      func(a int, b int) int { return a + b },
+			},
+			want: []int{101, 103},
+		},
+		{
+			name: "empty input results in nil output",
+			args: args[int, int]{
+				input:   channels.FromSlice([]int{}),
+				initial: 100,
+				fn:      func(a int, b int) int { return a + b },
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := channels.CollectAsSlice(channels.Scan(tt.args.input, tt.args.initial, tt.args.fn))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,3 +1,81 @@
 package dicts
 
-// TODO: Implement concurrent hash map
+import "sync"
+
+// ConcurrentHash is a hash map which is safe for concurrent use, guarded by a single lock.
+type ConcurrentHash[K comparable, V any] struct {
+	entries Hash[K, V]
+	lock    *sync.Mutex
+}
+
+func NewConcurrentHash[K comparable, V any](entries ...Pair[K, V]) *ConcurrentHash[K, V] {
+	return &ConcurrentHash[K, V]{
+		entries: NewHash(entries...),
+		lock:    &sync.Mutex{},
+	}
+}
+
+// Interface guards
+var _ Dict[int, int] = &ConcurrentHash[int, int]{}
+var _ MutableDict[int, int] = &ConcurrentHash[int, int]{}
+
+// ForEach calls the given function with each key and value in the hash, in no particular order.  The lock is held for
+// the whole iteration.
+func (h *ConcurrentHash[K, V]) ForEach(fn func(key K, value V)) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	for key, value := range h.entries {
+		fn(key, value)
+	}
+}
+
+// Get provides the value stored against the key, along with whether the key was found.
+func (h *ConcurrentHash[K, V]) Get(key K) (V, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.Get(key)
+}
+
+// GetMany looks up each of the given keys, returning a map of the entries which were found, along with a slice of the
+// keys which were missing, in the order they were given.  The lock is held once for the whole batch.
+func (h *ConcurrentHash[K, V]) GetMany(keys ...K) (map[K]V, []K) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.GetMany(keys...)
+}
+
+// Keys provides each of the keys in the hash, in no particular order.
+func (h *ConcurrentHash[K, V]) Keys() []K {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.Keys()
+}
+
+// Length provides the number of entries in the hash.
+func (h *ConcurrentHash[K, V]) Length() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.Length()
+}
+
+// Put stores the value against the key, replacing any value already stored against it.
+func (h *ConcurrentHash[K, V]) Put(key K, value V) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.entries[key] = value
+}
+
+// RemoveIfInPlace removes every entry for which the given function returns true, returning how many were removed.  The
+// lock is held once for the whole sweep.
+func (h *ConcurrentHash[K, V]) RemoveIfInPlace(fn func(key K, value V) bool) int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.RemoveIfInPlace(fn)
+}
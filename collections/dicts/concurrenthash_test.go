@@ -0,0 +1,87 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func ExampleConcurrentHash_GetMany() {
+	cache := dicts.NewConcurrentHash(
+		dicts.Pair[int, string]{Key: 1, Value: "alice"},
+		dicts.Pair[int, string]{Key: 2, Value: "bob"},
+	)
+
+	found, missing := cache.GetMany(1, 2, 3)
+
+	fmt.Printf("found: %v, missing: %v", found, missing)
+	// Output: found: map[1:alice 2:bob], missing: [3]
+}
+
+func TestConcurrentHash_GetMany(t *testing.T) {
+	type args[K comparable] struct {
+		keys []K
+	}
+	type testCase[K comparable, V any] struct {
+		name        string
+		entries     []dicts.Pair[K, V]
+		args        args[K]
+		wantFound   map[K]V
+		wantMissing []K
+	}
+	tests := []testCase[int, string]{
+		{
+			name:        "provides found entries and missing keys",
+			entries:     []dicts.Pair[int, string]{{Key: 1, Value: "one"}, {Key: 2, Value: "two"}},
+			args:        args[int]{keys: []int{3, 1}},
+			wantFound:   map[int]string{1: "one"},
+			wantMissing: []int{3},
+		},
+		{
+			name:        "empty hash provides every key as missing",
+			entries:     nil,
+			args:        args[int]{keys: []int{1, 2}},
+			wantFound:   map[int]string{},
+			wantMissing: []int{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := dicts.NewConcurrentHash(tt.entries...)
+			gotFound, gotMissing := h.GetMany(tt.args.keys...)
+			if !reflect.DeepEqual(gotFound, tt.wantFound) {
+				t.Errorf("GetMany() gotFound = %v, want %v", gotFound, tt.wantFound)
+			}
+			if !reflect.DeepEqual(gotMissing, tt.wantMissing) {
+				t.Errorf("GetMany() gotMissing = %v, want %v", gotMissing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestConcurrentHash_ConcurrentPuts(t *testing.T) {
+	h := dicts.NewConcurrentHash[int, int]()
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for i := 0; i < 250; i++ {
+				h.Put(worker*250+i, i)
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	if got := h.Length(); got != 1_000 {
+		t.Errorf("Length() = %v, want 1000", got)
+	}
+	removed := h.RemoveIfInPlace(func(key int, value int) bool {
+		return value >= 125
+	})
+	if removed != 500 {
+		t.Errorf("RemoveIfInPlace() = %v, want 500", removed)
+	}
+}
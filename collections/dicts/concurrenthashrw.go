@@ -1,3 +1,96 @@
 package dicts
 
-// TODO: Implement concurrent hash map with a read-write lock.
+import "sync"
+
+// ConcurrentHashRW is a hash map which is safe for concurrent use, guarded by a read-write lock so that many readers
+// may access the entries at once.
+type ConcurrentHashRW[K comparable, V any] struct {
+	entries Hash[K, V]
+	lock    *sync.RWMutex
+}
+
+func NewConcurrentHashRW[K comparable, V any](entries ...Pair[K, V]) *ConcurrentHashRW[K, V] {
+	return &ConcurrentHashRW[K, V]{
+		entries: NewHash(entries...),
+		lock:    &sync.RWMutex{},
+	}
+}
+
+// Interface guards
+var _ Dict[int, int] = &ConcurrentHashRW[int, int]{}
+var _ MutableDict[int, int] = &ConcurrentHashRW[int, int]{}
+
+// ForEach calls the given function with each key and value in the hash, in no particular order.  The read lock is held
+// for the whole iteration, blocking writers until it completes - use Snapshot to iterate without holding the lock.
+func (h *ConcurrentHashRW[K, V]) ForEach(fn func(key K, value V)) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	for key, value := range h.entries {
+		fn(key, value)
+	}
+}
+
+// Get provides the value stored against the key, along with whether the key was found.
+func (h *ConcurrentHashRW[K, V]) Get(key K) (V, bool) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.entries.Get(key)
+}
+
+// GetMany looks up each of the given keys, returning a map of the entries which were found, along with a slice of the
+// keys which were missing, in the order they were given.  The read lock is held once for the whole batch.
+func (h *ConcurrentHashRW[K, V]) GetMany(keys ...K) (map[K]V, []K) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.entries.GetMany(keys...)
+}
+
+// Keys provides each of the keys in the hash, in no particular order.
+func (h *ConcurrentHashRW[K, V]) Keys() []K {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.entries.Keys()
+}
+
+// Length provides the number of entries in the hash.
+func (h *ConcurrentHashRW[K, V]) Length() int {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.entries.Length()
+}
+
+// Put stores the value against the key, replacing any value already stored against it.
+func (h *ConcurrentHashRW[K, V]) Put(key K, value V) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.entries[key] = value
+}
+
+// RemoveIfInPlace removes every entry for which the given function returns true, returning how many were removed.  The
+// write lock is held once for the whole sweep.
+func (h *ConcurrentHashRW[K, V]) RemoveIfInPlace(fn func(key K, value V) bool) int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.entries.RemoveIfInPlace(fn)
+}
+
+// Snapshot provides a copy of the entries in the hash, taking the read lock only for as long as the copy takes.  The
+// snapshot is not updated by later writes, so may be slightly stale, but can be iterated for as long as needed without
+// blocking writers.
+func (h *ConcurrentHashRW[K, V]) Snapshot() Dict[K, V] {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	snapshot := make(Hash[K, V], len(h.entries))
+	for key, value := range h.entries {
+		snapshot[key] = value
+	}
+	return snapshot
+}
@@ -0,0 +1,120 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func ExampleConcurrentHashRW_Snapshot() {
+	config := dicts.NewConcurrentHashRW(dicts.Pair[string, int]{Key: "retries", Value: 3})
+
+	snapshot := config.Snapshot()
+	config.Put("retries", 5)
+
+	before, _ := snapshot.Get("retries")
+	after, _ := config.Get("retries")
+	fmt.Printf("snapshot: %v, current: %v", before, after)
+	// Output: snapshot: 3, current: 5
+}
+
+func TestConcurrentHashRW_Snapshot(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []dicts.Pair[string, int]
+		want    dicts.Hash[string, int]
+	}{
+		{
+			name: "snapshot holds every entry",
+			entries: []dicts.Pair[string, int]{
+				{Key: "one", Value: 1},
+				{Key: "two", Value: 2},
+			},
+			want: dicts.Hash[string, int]{"one": 1, "two": 2},
+		},
+		{
+			name:    "empty hash provides an empty snapshot",
+			entries: nil,
+			want:    dicts.Hash[string, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := dicts.NewConcurrentHashRW(tt.entries...)
+			got := h.Snapshot()
+			if !dicts.Equal[string, int](got, tt.want) {
+				t.Errorf("Snapshot() = %v, want %v", got, tt.want)
+			}
+			h.Put("three", 3)
+			if _, ok := got.Get("three"); ok {
+				t.Errorf("Snapshot() was modified by a later Put")
+			}
+		})
+	}
+}
+
+func TestConcurrentHashRW_SnapshotDuringWrites(t *testing.T) {
+	h := dicts.NewConcurrentHashRW[int, int]()
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1_000; i++ {
+			h.Put(i, i)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		snapshot := h.Snapshot()
+		for _, key := range snapshot.Keys() {
+			if value, _ := snapshot.Get(key); value != key {
+				t.Fatalf("Snapshot() value for %v = %v, want %v", key, value, key)
+			}
+		}
+	}
+	wg.Wait()
+	if got := h.Snapshot().Length(); got != 1_000 {
+		t.Errorf("Snapshot().Length() = %v, want 1000", got)
+	}
+}
+
+func TestConcurrentHashRW_RemoveIfInPlace(t *testing.T) {
+	h := dicts.NewConcurrentHashRW[int, int]()
+	for i := 0; i < 10; i++ {
+		h.Put(i, i)
+	}
+
+	removed := h.RemoveIfInPlace(func(key int, value int) bool {
+		return value >= 6
+	})
+
+	if removed != 4 {
+		t.Errorf("RemoveIfInPlace() = %v, want 4", removed)
+	}
+	if !dicts.Equal[int, int](h, dicts.Hash[int, int]{0: 0, 1: 1, 2: 2, 3: 3, 4: 4, 5: 5}) {
+		t.Errorf("RemoveIfInPlace() resulted in %v entries, want 6", h.Length())
+	}
+}
+
+func TestConcurrentHashRW_GetMany(t *testing.T) {
+	h := dicts.NewConcurrentHashRW(dicts.Pair[int, string]{Key: 1, Value: "one"}, dicts.Pair[int, string]{Key: 2, Value: "two"})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 10; i < 1_000; i++ {
+			h.Put(i, "many")
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		found, missing := h.GetMany(2, 3, 1)
+		if !reflect.DeepEqual(found, map[int]string{1: "one", 2: "two"}) {
+			t.Fatalf("GetMany() found = %v, want %v", found, map[int]string{1: "one", 2: "two"})
+		}
+		if !reflect.DeepEqual(missing, []int{3}) {
+			t.Fatalf("GetMany() missing = %v, want %v", missing, []int{3})
+		}
+	}
+	wg.Wait()
+}
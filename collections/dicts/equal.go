@@ -0,0 +1,23 @@
+package dicts
+
+// Equal determines whether the two dicts hold the same set of keys, with equal values stored against each key.  The
+// dicts may be of different implementations.  Each dict is read in a single pass using its ForEach, so a concurrent
+// dict holds its read lock once and is compared as it stood at that moment, rather than as a mix of the states before
+// and after a concurrent write.  The two dicts are never locked at the same time.
+func Equal[K, V comparable](a, b Dict[K, V]) bool {
+	entriesB, ok := b.(Hash[K, V])
+	if !ok {
+		entriesB = Hash[K, V]{}
+		b.ForEach(func(key K, value V) {
+			entriesB[key] = value
+		})
+	}
+	equal, count := true, 0
+	a.ForEach(func(key K, value V) {
+		count++
+		if valueB, ok := entriesB[key]; !ok || valueB != value {
+			equal = false
+		}
+	})
+	return equal && count == len(entriesB)
+}
@@ -0,0 +1,129 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"sync"
+	"testing"
+)
+
+func ExampleEqual() {
+	expected := dicts.NewTree(
+		dicts.Pair[string, int]{Key: "retries", Value: 3},
+		dicts.Pair[string, int]{Key: "timeout", Value: 30},
+	)
+	actual := dicts.NewHash(
+		dicts.Pair[string, int]{Key: "timeout", Value: 30},
+		dicts.Pair[string, int]{Key: "retries", Value: 3},
+	)
+
+	fmt.Printf("equal: %v", dicts.Equal[string, int](expected, actual))
+	// Output: equal: true
+}
+
+func TestEqual(t *testing.T) {
+	type args[K comparable, V comparable] struct {
+		a dicts.Dict[K, V]
+		b dicts.Dict[K, V]
+	}
+	type testCase[K comparable, V comparable] struct {
+		name string
+		args args[K, V]
+		want bool
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "same entries across implementations are equal",
+			args: args[int, string]{
+				a: dicts.NewTree(dicts.Pair[int, string]{Key: 1, Value: "one"}, dicts.Pair[int, string]{Key: 2, Value: "two"}),
+				b: dicts.Hash[int, string]{1: "one", 2: "two"},
+			},
+			want: true,
+		},
+		{
+			name: "different values are not equal",
+			args: args[int, string]{
+				a: dicts.Hash[int, string]{1: "one", 2: "two"},
+				b: dicts.Hash[int, string]{1: "one", 2: "deux"},
+			},
+			want: false,
+		},
+		{
+			name: "different keys are not equal",
+			args: args[int, string]{
+				a: dicts.Hash[int, string]{1: "one", 2: "two"},
+				b: dicts.NewTree(dicts.Pair[int, string]{Key: 1, Value: "one"}, dicts.Pair[int, string]{Key: 3, Value: "two"}),
+			},
+			want: false,
+		},
+		{
+			name: "different lengths are not equal",
+			args: args[int, string]{
+				a: dicts.Hash[int, string]{1: "one"},
+				b: dicts.Hash[int, string]{1: "one", 2: "two"},
+			},
+			want: false,
+		},
+		{
+			name: "concurrent dicts are compared by their entries",
+			args: args[int, string]{
+				a: dicts.NewConcurrentHashRW(dicts.Pair[int, string]{Key: 1, Value: "one"}, dicts.Pair[int, string]{Key: 2, Value: "two"}),
+				b: dicts.NewTree(dicts.Pair[int, string]{Key: 2, Value: "two"}, dicts.Pair[int, string]{Key: 1, Value: "one"}),
+			},
+			want: true,
+		},
+		{
+			name: "extra keys in the second dict are not equal",
+			args: args[int, string]{
+				a: dicts.NewTree(dicts.Pair[int, string]{Key: 1, Value: "one"}),
+				b: dicts.NewConcurrentHashRW(dicts.Pair[int, string]{Key: 1, Value: "one"}, dicts.Pair[int, string]{Key: 2, Value: "two"}),
+			},
+			want: false,
+		},
+		{
+			name: "empty dicts are equal",
+			args: args[int, string]{
+				a: dicts.NewHash[int, string](),
+				b: dicts.NewTree[int, string](),
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dicts.Equal(tt.args.a, tt.args.b)
+			if got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqual_ConcurrentWriter(t *testing.T) {
+	actual := dicts.NewConcurrentHashRW(dicts.Pair[int, int]{Key: 1, Value: 10}, dicts.Pair[int, int]{Key: 2, Value: 20})
+	expected := dicts.Hash[int, int]{1: 10, 2: 20}
+
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+				actual.Put(1, 10)
+				actual.Put(2, 20)
+			}
+		}
+	}()
+
+	for i := 0; i < 100; i++ {
+		if !dicts.Equal[int, int](actual, expected) {
+			t.Errorf("Equal() = false, want true")
+		}
+	}
+	close(stop)
+	wg.Wait()
+}
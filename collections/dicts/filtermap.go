@@ -0,0 +1,23 @@
+package dicts
+
+// FilterMapFunc is a function which transforms the value stored against a key, also reporting whether the entry
+// should be kept.
+type FilterMapFunc[K comparable, V, OV any] func(key K, value V) (OV, bool)
+
+// FilterMap transforms each entry of the given dict with the provided function, keeping only the entries for which the
+// function reports true, in a single pass.  The entries are visited using the dict's ForEach, so a Tree is visited in
+// key order and a ConcurrentHashRW holds its read lock once for the whole pass.  When the dict visits its entries in a
+// defined order - a Tree or an OrderedHash - the result is a new OrderedHash which keeps the entries in that same
+// order.  Otherwise, the result is a new Hash.
+func FilterMap[K comparable, V, OV any](d Dict[K, V], fn FilterMapFunc[K, V, OV]) Dict[K, OV] {
+	var results MutableDict[K, OV] = Hash[K, OV]{}
+	if _, ok := d.(orderedDict); ok {
+		results = NewOrderedHash[K, OV]()
+	}
+	d.ForEach(func(key K, value V) {
+		if output, ok := fn(key, value); ok {
+			results.Put(key, output)
+		}
+	})
+	return results
+}
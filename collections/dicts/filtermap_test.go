@@ -0,0 +1,131 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func ExampleFilterMap() {
+	config := dicts.NewHash(
+		dicts.Pair[string, string]{Key: "retries", Value: "3"},
+		dicts.Pair[string, string]{Key: "host", Value: "localhost"},
+	)
+
+	numeric := dicts.FilterMap[string, string, int](config, func(key string, value string) (int, bool) {
+		number, err := strconv.Atoi(value)
+		return number, err == nil
+	})
+
+	retries, _ := numeric.Get("retries")
+	fmt.Printf("numeric entries: %v, retries: %v", numeric.Length(), retries)
+	// Output: numeric entries: 1, retries: 3
+}
+
+func TestFilterMap(t *testing.T) {
+	parse := func(key int, value string) (int, bool) {
+		number, err := strconv.Atoi(value)
+		return number, err == nil
+	}
+	type args[K comparable, V any, OV any] struct {
+		d  dicts.Dict[K, V]
+		fn dicts.FilterMapFunc[K, V, OV]
+	}
+	type testCase[K comparable, V any, OV any] struct {
+		name string
+		args args[K, V, OV]
+		want dicts.Hash[K, OV]
+	}
+	tests := []testCase[int, string, int]{
+		{
+			name: "keeps and transforms matching entries of a hash",
+			args: args[int, string, int]{
+				d:  dicts.Hash[int, string]{1: "10", 2: "twenty", 3: "30"},
+				fn: parse,
+			},
+			want: dicts.Hash[int, int]{1: 10, 3: 30},
+		},
+		{
+			name: "keeps and transforms matching entries of a tree",
+			args: args[int, string, int]{
+				d: dicts.NewTree(
+					dicts.Pair[int, string]{Key: 2, Value: "twenty"},
+					dicts.Pair[int, string]{Key: 1, Value: "10"},
+				),
+				fn: parse,
+			},
+			want: dicts.Hash[int, int]{1: 10},
+		},
+		{
+			name: "keeps and transforms matching entries of a concurrent hash",
+			args: args[int, string, int]{
+				d:  dicts.NewConcurrentHashRW(dicts.Pair[int, string]{Key: 5, Value: "50"}),
+				fn: parse,
+			},
+			want: dicts.Hash[int, int]{5: 50},
+		},
+		{
+			name: "empty dict provides an empty result",
+			args: args[int, string, int]{
+				d:  dicts.NewHash[int, string](),
+				fn: parse,
+			},
+			want: dicts.Hash[int, int]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := dicts.FilterMap(tt.args.d, tt.args.fn)
+			if !dicts.Equal[int, int](got, tt.want) {
+				t.Errorf("FilterMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterMap_KeepsOrder(t *testing.T) {
+	parse := func(key int, value string) (int, bool) {
+		number, err := strconv.Atoi(value)
+		return number, err == nil
+	}
+	tests := []struct {
+		name     string
+		d        dicts.Dict[int, string]
+		wantKeys []int
+	}{
+		{
+			name: "tree keeps key order",
+			d: dicts.NewTree(
+				dicts.Pair[int, string]{Key: 5, Value: "50"},
+				dicts.Pair[int, string]{Key: 1, Value: "10"},
+				dicts.Pair[int, string]{Key: 3, Value: "thirty"},
+				dicts.Pair[int, string]{Key: 4, Value: "40"},
+				dicts.Pair[int, string]{Key: 2, Value: "20"},
+			),
+			wantKeys: []int{1, 2, 4, 5},
+		},
+		{
+			name: "ordered hash keeps insertion order",
+			d: dicts.NewOrderedHash(
+				dicts.Pair[int, string]{Key: 5, Value: "50"},
+				dicts.Pair[int, string]{Key: 1, Value: "10"},
+				dicts.Pair[int, string]{Key: 3, Value: "thirty"},
+				dicts.Pair[int, string]{Key: 2, Value: "20"},
+			),
+			wantKeys: []int{5, 1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKeys []int
+			dicts.FilterMap(tt.d, parse).ForEach(func(key int, value int) {
+				gotKeys = append(gotKeys, key)
+			})
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("FilterMap() keys = %v, want %v", gotKeys, tt.wantKeys)
+			}
+		})
+	}
+}
@@ -9,3 +9,67 @@ func NewHash[K comparable, V any](entries ...Pair[K, V]) Hash[K, V] {
 	}
 	return m
 }
+
+// Interface guards
+var _ Dict[int, int] = Hash[int, int]{}
+var _ MutableDict[int, int] = Hash[int, int]{}
+
+// ForEach calls the given function with each key and value in the hash, in no particular order.
+func (h Hash[K, V]) ForEach(fn func(key K, value V)) {
+	for key, value := range h {
+		fn(key, value)
+	}
+}
+
+// Get provides the value stored against the key, along with whether the key was found.
+func (h Hash[K, V]) Get(key K) (V, bool) {
+	value, ok := h[key]
+	return value, ok
+}
+
+// GetMany looks up each of the given keys, returning a map of the entries which were found, along with a slice of the
+// keys which were missing, in the order they were given.
+func (h Hash[K, V]) GetMany(keys ...K) (map[K]V, []K) {
+	found := make(map[K]V, len(keys))
+	var missing []K
+	for _, key := range keys {
+		value, ok := h[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		found[key] = value
+	}
+	return found, missing
+}
+
+// Keys provides each of the keys in the hash, in no particular order.
+func (h Hash[K, V]) Keys() []K {
+	var results []K
+	for key := range h {
+		results = append(results, key)
+	}
+	return results
+}
+
+// Length provides the number of entries in the hash.
+func (h Hash[K, V]) Length() int {
+	return len(h)
+}
+
+// Put stores the value against the key, replacing any value already stored against it.
+func (h Hash[K, V]) Put(key K, value V) {
+	h[key] = value
+}
+
+// RemoveIfInPlace removes every entry for which the given function returns true, returning how many were removed.
+func (h Hash[K, V]) RemoveIfInPlace(fn func(key K, value V) bool) int {
+	removed := 0
+	for key, value := range h {
+		if fn(key, value) {
+			delete(h, key)
+			removed++
+		}
+	}
+	return removed
+}
@@ -0,0 +1,178 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleHash_GetMany() {
+	h := dicts.NewHash(
+		dicts.Pair[int, string]{Key: 1, Value: "one"},
+		dicts.Pair[int, string]{Key: 2, Value: "two"},
+	)
+
+	found, missing := h.GetMany(1, 2, 3)
+
+	fmt.Printf("found: %v, missing: %v", found, missing)
+	// Output: found: map[1:one 2:two], missing: [3]
+}
+
+func TestHash_GetMany(t *testing.T) {
+	type args[K comparable] struct {
+		keys []K
+	}
+	type testCase[K comparable, V any] struct {
+		name        string
+		h           dicts.Hash[K, V]
+		args        args[K]
+		wantFound   map[K]V
+		wantMissing []K
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "provides found entries and missing keys",
+			h:    dicts.Hash[int, string]{1: "one", 2: "two", 3: "three"},
+			args: args[int]{
+				keys: []int{3, 4, 1, 5},
+			},
+			wantFound:   map[int]string{1: "one", 3: "three"},
+			wantMissing: []int{4, 5},
+		},
+		{
+			name: "all keys found provides nil missing keys",
+			h:    dicts.Hash[int, string]{1: "one", 2: "two"},
+			args: args[int]{
+				keys: []int{1, 2},
+			},
+			wantFound:   map[int]string{1: "one", 2: "two"},
+			wantMissing: nil,
+		},
+		{
+			name: "empty hash provides every key as missing",
+			h:    dicts.NewHash[int, string](),
+			args: args[int]{
+				keys: []int{1, 2},
+			},
+			wantFound:   map[int]string{},
+			wantMissing: []int{1, 2},
+		},
+		{
+			name: "no keys provides empty found entries",
+			h:    dicts.Hash[int, string]{1: "one"},
+			args: args[int]{
+				keys: nil,
+			},
+			wantFound:   map[int]string{},
+			wantMissing: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotFound, gotMissing := tt.h.GetMany(tt.args.keys...)
+			if !reflect.DeepEqual(gotFound, tt.wantFound) {
+				t.Errorf("GetMany() gotFound = %v, want %v", gotFound, tt.wantFound)
+			}
+			if !reflect.DeepEqual(gotMissing, tt.wantMissing) {
+				t.Errorf("GetMany() gotMissing = %v, want %v", gotMissing, tt.wantMissing)
+			}
+		})
+	}
+}
+
+func TestHash_Keys(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name       string
+		h          dicts.Hash[K, V]
+		wantKeys   []K
+		wantLength int
+	}
+	tests := []testCase[int, string]{
+		{
+			name:       "provides every key",
+			h:          dicts.Hash[int, string]{3: "three", 1: "one", 2: "two"},
+			wantKeys:   []int{1, 2, 3},
+			wantLength: 3,
+		},
+		{
+			name:       "empty hash provides nil keys",
+			h:          dicts.NewHash[int, string](),
+			wantKeys:   nil,
+			wantLength: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotKeys := slices.SortOrderedAsc(tt.h.Keys())
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", gotKeys, tt.wantKeys)
+			}
+			if tt.h.Length() != tt.wantLength {
+				t.Errorf("Length() = %v, want %v", tt.h.Length(), tt.wantLength)
+			}
+		})
+	}
+}
+
+func ExampleHash_RemoveIfInPlace() {
+	cache := dicts.NewHash(
+		dicts.Pair[string, int]{Key: "a", Value: 5},
+		dicts.Pair[string, int]{Key: "b", Value: 50},
+		dicts.Pair[string, int]{Key: "c", Value: 500},
+	)
+
+	evicted := cache.RemoveIfInPlace(func(key string, expiresAt int) bool {
+		return expiresAt < 100
+	})
+
+	fmt.Printf("evicted: %v, remaining: %v", evicted, cache)
+	// Output: evicted: 2, remaining: map[c:500]
+}
+
+func TestHash_RemoveIfInPlace(t *testing.T) {
+	isEven := func(key int, value string) bool {
+		return key%2 == 0
+	}
+	type testCase[K comparable, V any] struct {
+		name     string
+		h        dicts.Hash[K, V]
+		fn       func(key K, value V) bool
+		want     int
+		wantHash dicts.Hash[K, V]
+	}
+	tests := []testCase[int, string]{
+		{
+			name:     "removes matching entries",
+			h:        dicts.Hash[int, string]{1: "one", 2: "two", 3: "three", 4: "four"},
+			fn:       isEven,
+			want:     2,
+			wantHash: dicts.Hash[int, string]{1: "one", 3: "three"},
+		},
+		{
+			name:     "no matching entries removes nothing",
+			h:        dicts.Hash[int, string]{1: "one"},
+			fn:       isEven,
+			want:     0,
+			wantHash: dicts.Hash[int, string]{1: "one"},
+		},
+		{
+			name:     "empty hash removes nothing",
+			h:        dicts.Hash[int, string]{},
+			fn:       isEven,
+			want:     0,
+			wantHash: dicts.Hash[int, string]{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.RemoveIfInPlace(tt.fn); got != tt.want {
+				t.Errorf("RemoveIfInPlace() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.h, tt.wantHash) {
+				t.Errorf("RemoveIfInPlace() resulted in %v, want %v", tt.h, tt.wantHash)
+			}
+		})
+	}
+}
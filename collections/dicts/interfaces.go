@@ -1,4 +1,20 @@
 package dicts
 
 type Dict[K comparable, V any] interface {
+	ForEach(fn func(key K, value V))
+	Get(key K) (V, bool)
+	Keys() []K
+	Length() int
+}
+
+// orderedDict is implemented by the dicts whose ForEach visits the entries in a defined order, rather than in no
+// particular order.
+type orderedDict interface {
+	ordered()
+}
+
+type MutableDict[K comparable, V any] interface {
+	Dict[K, V]
+	Put(key K, value V)
+	RemoveIfInPlace(fn func(key K, value V) bool) int
 }
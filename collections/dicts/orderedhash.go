@@ -0,0 +1,122 @@
+package dicts
+
+// OrderedHash is a hash map which remembers the order in which its keys were first added, visiting them in that order.
+// It is backed by a slice of entries held in insertion order, along with a map from each key to its position in that
+// slice.
+type OrderedHash[K comparable, V any] struct {
+	entries []Pair[K, V]
+	indexes map[K]int
+}
+
+// NewOrderedHash creates an OrderedHash holding the given entries, in the order given.  If a key is given more than
+// once, its last value is kept at the position of its first occurrence.
+func NewOrderedHash[K comparable, V any](entries ...Pair[K, V]) *OrderedHash[K, V] {
+	h := &OrderedHash[K, V]{
+		entries: make([]Pair[K, V], 0, len(entries)),
+		indexes: make(map[K]int, len(entries)),
+	}
+	for _, entry := range entries {
+		h.Put(entry.Key, entry.Value)
+	}
+	return h
+}
+
+// Interface guards
+var _ Dict[int, int] = &OrderedHash[int, int]{}
+var _ MutableDict[int, int] = &OrderedHash[int, int]{}
+
+// Entries provides a copy of each key and value in the hash, in insertion order.
+func (h *OrderedHash[K, V]) Entries() []Pair[K, V] {
+	if len(h.entries) == 0 {
+		return nil
+	}
+	results := make([]Pair[K, V], len(h.entries))
+	copy(results, h.entries)
+	return results
+}
+
+// ForEach calls the given function with each key and value in the hash, in insertion order.
+func (h *OrderedHash[K, V]) ForEach(fn func(key K, value V)) {
+	for _, entry := range h.entries {
+		fn(entry.Key, entry.Value)
+	}
+}
+
+// Get provides the value stored against the key, along with whether the key was found.
+func (h *OrderedHash[K, V]) Get(key K) (V, bool) {
+	idx, ok := h.indexes[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return h.entries[idx].Value, true
+}
+
+// Keys provides each of the keys in the hash, in insertion order.
+func (h *OrderedHash[K, V]) Keys() []K {
+	var results []K
+	for _, entry := range h.entries {
+		results = append(results, entry.Key)
+	}
+	return results
+}
+
+// Length provides the number of entries in the hash.
+func (h *OrderedHash[K, V]) Length() int {
+	return len(h.entries)
+}
+
+// Put stores the value against the key.  A new key is added after every existing key, while an existing key has its
+// value replaced and keeps its original position.
+func (h *OrderedHash[K, V]) Put(key K, value V) {
+	if h.indexes == nil {
+		h.indexes = map[K]int{}
+	}
+	if idx, ok := h.indexes[key]; ok {
+		h.entries[idx].Value = value
+		return
+	}
+	h.indexes[key] = len(h.entries)
+	h.entries = append(h.entries, Pair[K, V]{Key: key, Value: value})
+}
+
+// Remove removes the entry stored against the key, returning whether it was found.  The remaining entries keep their
+// relative order.  Removal takes O(n) time, as each of the entries after the removed one are shifted down.
+func (h *OrderedHash[K, V]) Remove(key K) bool {
+	idx, ok := h.indexes[key]
+	if !ok {
+		return false
+	}
+	delete(h.indexes, key)
+	copy(h.entries[idx:], h.entries[idx+1:])
+	h.entries[len(h.entries)-1] = Pair[K, V]{}
+	h.entries = h.entries[:len(h.entries)-1]
+	for i := idx; i < len(h.entries); i++ {
+		h.indexes[h.entries[i].Key] = i
+	}
+	return true
+}
+
+// RemoveIfInPlace removes every entry for which the given function returns true, returning how many were removed.  The
+// remaining entries keep their relative order, and are compacted in a single pass.
+func (h *OrderedHash[K, V]) RemoveIfInPlace(fn func(key K, value V) bool) int {
+	kept := 0
+	for _, entry := range h.entries {
+		if fn(entry.Key, entry.Value) {
+			delete(h.indexes, entry.Key)
+			continue
+		}
+		h.entries[kept] = entry
+		h.indexes[entry.Key] = kept
+		kept++
+	}
+	removed := len(h.entries) - kept
+	for i := kept; i < len(h.entries); i++ {
+		h.entries[i] = Pair[K, V]{}
+	}
+	h.entries = h.entries[:kept]
+	return removed
+}
+
+// ordered marks the hash as visiting its entries in insertion order.
+func (h *OrderedHash[K, V]) ordered() {}
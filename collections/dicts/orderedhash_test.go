@@ -0,0 +1,199 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"reflect"
+	"testing"
+)
+
+func ExampleNewOrderedHash() {
+	fields := dicts.NewOrderedHash(
+		dicts.Pair[string, string]{Key: "name", Value: "Ada"},
+		dicts.Pair[string, string]{Key: "email", Value: "ada@example.com"},
+		dicts.Pair[string, string]{Key: "role", Value: "admin"},
+	)
+	fields.Remove("email")
+	fields.Put("team", "core")
+
+	fields.ForEach(func(key string, value string) {
+		fmt.Printf("%v=%v ", key, value)
+	})
+	// Output: name=Ada role=admin team=core
+}
+
+func TestOrderedHash_Put(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name        string
+		h           *dicts.OrderedHash[K, V]
+		puts        []dicts.Pair[K, V]
+		wantEntries []dicts.Pair[K, V]
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "new keys are added in insertion order",
+			h:    dicts.NewOrderedHash[string, int](),
+			puts: []dicts.Pair[string, int]{{Key: "c", Value: 3}, {Key: "a", Value: 1}, {Key: "b", Value: 2}},
+			wantEntries: []dicts.Pair[string, int]{
+				{Key: "c", Value: 3}, {Key: "a", Value: 1}, {Key: "b", Value: 2},
+			},
+		},
+		{
+			name: "existing keys keep their position",
+			h: dicts.NewOrderedHash(
+				dicts.Pair[string, int]{Key: "a", Value: 1},
+				dicts.Pair[string, int]{Key: "b", Value: 2},
+			),
+			puts: []dicts.Pair[string, int]{{Key: "a", Value: 10}, {Key: "c", Value: 3}},
+			wantEntries: []dicts.Pair[string, int]{
+				{Key: "a", Value: 10}, {Key: "b", Value: 2}, {Key: "c", Value: 3},
+			},
+		},
+		{
+			name:        "zero value hash can be used",
+			h:           &dicts.OrderedHash[string, int]{},
+			puts:        []dicts.Pair[string, int]{{Key: "a", Value: 1}},
+			wantEntries: []dicts.Pair[string, int]{{Key: "a", Value: 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, entry := range tt.puts {
+				tt.h.Put(entry.Key, entry.Value)
+			}
+			if got := tt.h.Entries(); !reflect.DeepEqual(got, tt.wantEntries) {
+				t.Errorf("Entries() = %v, want %v", got, tt.wantEntries)
+			}
+			if tt.h.Length() != len(tt.wantEntries) {
+				t.Errorf("Length() = %v, want %v", tt.h.Length(), len(tt.wantEntries))
+			}
+		})
+	}
+}
+
+func TestOrderedHash_Remove(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name     string
+		h        *dicts.OrderedHash[K, V]
+		key      K
+		want     bool
+		wantKeys []K
+	}
+	newHash := func() *dicts.OrderedHash[string, int] {
+		return dicts.NewOrderedHash(
+			dicts.Pair[string, int]{Key: "a", Value: 1},
+			dicts.Pair[string, int]{Key: "b", Value: 2},
+			dicts.Pair[string, int]{Key: "c", Value: 3},
+		)
+	}
+	tests := []testCase[string, int]{
+		{
+			name:     "removing from the middle keeps order",
+			h:        newHash(),
+			key:      "b",
+			want:     true,
+			wantKeys: []string{"a", "c"},
+		},
+		{
+			name:     "removing the first entry keeps order",
+			h:        newHash(),
+			key:      "a",
+			want:     true,
+			wantKeys: []string{"b", "c"},
+		},
+		{
+			name:     "removing the last entry keeps order",
+			h:        newHash(),
+			key:      "c",
+			want:     true,
+			wantKeys: []string{"a", "b"},
+		},
+		{
+			name:     "missing key removes nothing",
+			h:        newHash(),
+			key:      "z",
+			want:     false,
+			wantKeys: []string{"a", "b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.Remove(tt.key); got != tt.want {
+				t.Errorf("Remove() = %v, want %v", got, tt.want)
+			}
+			if got := tt.h.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			for _, key := range tt.wantKeys {
+				if _, ok := tt.h.Get(key); !ok {
+					t.Errorf("Get(%v) did not find a remaining key", key)
+				}
+			}
+			if _, ok := tt.h.Get(tt.key); ok {
+				t.Errorf("Get(%v) found a removed key", tt.key)
+			}
+		})
+	}
+}
+
+func TestOrderedHash_RemoveIfInPlace(t *testing.T) {
+	isEven := func(key string, value int) bool {
+		return value%2 == 0
+	}
+	type testCase[K comparable, V any] struct {
+		name        string
+		h           *dicts.OrderedHash[K, V]
+		fn          func(key K, value V) bool
+		want        int
+		wantEntries []dicts.Pair[K, V]
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "removes matching entries and keeps order",
+			h: dicts.NewOrderedHash(
+				dicts.Pair[string, int]{Key: "d", Value: 4},
+				dicts.Pair[string, int]{Key: "a", Value: 1},
+				dicts.Pair[string, int]{Key: "b", Value: 2},
+				dicts.Pair[string, int]{Key: "c", Value: 3},
+			),
+			fn:   isEven,
+			want: 2,
+			wantEntries: []dicts.Pair[string, int]{
+				{Key: "a", Value: 1}, {Key: "c", Value: 3},
+			},
+		},
+		{
+			name:        "removing every entry provides nil entries",
+			h:           dicts.NewOrderedHash(dicts.Pair[string, int]{Key: "b", Value: 2}),
+			fn:          isEven,
+			want:        1,
+			wantEntries: nil,
+		},
+		{
+			name:        "empty hash removes nothing",
+			h:           dicts.NewOrderedHash[string, int](),
+			fn:          isEven,
+			want:        0,
+			wantEntries: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.h.RemoveIfInPlace(tt.fn); got != tt.want {
+				t.Errorf("RemoveIfInPlace() = %v, want %v", got, tt.want)
+			}
+			if got := tt.h.Entries(); !reflect.DeepEqual(got, tt.wantEntries) {
+				t.Errorf("Entries() = %v, want %v", got, tt.wantEntries)
+			}
+			for _, entry := range tt.wantEntries {
+				if value, ok := tt.h.Get(entry.Key); !ok || value != entry.Value {
+					t.Errorf("Get(%v) = %v, %v, want %v, true", entry.Key, value, ok, entry.Value)
+				}
+			}
+			tt.h.Put("z", 26)
+			if got := tt.h.Keys(); got[len(got)-1] != "z" {
+				t.Errorf("Put() after removal added at %v, want the end", got)
+			}
+		})
+	}
+}
@@ -1,28 +1,166 @@
 package dicts
 
-type node[K comparable, V any] struct {
+import "github.com/pickeringtech/go-collections/constraints"
+
+type node[K constraints.Ordered, V any] struct {
 	Key   K
 	Value V
 	Left  *node[K, V]
 	Right *node[K, V]
 }
 
-type Tree[K comparable, V any] struct {
+type Tree[K constraints.Ordered, V any] struct {
 	Root *node[K, V]
+	size int
 }
 
-func NewTree[K comparable, V any](entries ...Pair[K, V]) Tree[K, V] {
-	t := Tree[K, V]{}
+// Interface guards
+var _ Dict[int, int] = &Tree[int, int]{}
+
+func NewTree[K constraints.Ordered, V any](entries ...Pair[K, V]) *Tree[K, V] {
+	t := &Tree[K, V]{}
 	for _, entry := range entries {
 		t.Put(entry.Key, entry.Value)
 	}
 	return t
 }
 
-func (t Tree[K, V]) Put(key K, value V) {
-	if t.Root == nil {
-		t.Root = &node[K, V]{Key: key, Value: value}
+// NewTreeFromSorted builds a balanced tree from the given entries in O(n) time, by taking the middle entry of each range
+// as the root of that range.  The entries must already be sorted by key in ascending order, with no duplicate keys - if
+// they are not, the resulting tree will not find its entries correctly.
+func NewTreeFromSorted[K constraints.Ordered, V any](entries []Pair[K, V]) *Tree[K, V] {
+	return &Tree[K, V]{
+		Root: buildBalanced(entries),
+		size: len(entries),
+	}
+}
+
+// buildBalanced creates a balanced subtree from the given sorted entries, returning its root.
+func buildBalanced[K constraints.Ordered, V any](entries []Pair[K, V]) *node[K, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	mid := len(entries) / 2
+	return &node[K, V]{
+		Key:   entries[mid].Key,
+		Value: entries[mid].Value,
+		Left:  buildBalanced(entries[:mid]),
+		Right: buildBalanced(entries[mid+1:]),
+	}
+}
+
+// ForEach calls the given function with each key and value in the tree, in ascending order of key.
+func (t *Tree[K, V]) ForEach(fn func(key K, value V)) {
+	t.each(t.Root, func(n *node[K, V]) {
+		fn(n.Key, n.Value)
+	})
+}
+
+// Get provides the value stored against the key, along with whether the key was found.
+func (t *Tree[K, V]) Get(key K) (V, bool) {
+	current := t.Root
+	for current != nil {
+		switch {
+		case key < current.Key:
+			current = current.Left
+		case key > current.Key:
+			current = current.Right
+		default:
+			return current.Value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Height provides the number of nodes on the longest path from the root of the tree down to a leaf.  An empty tree has
+// a height of 0, and a tree of a single entry has a height of 1.
+func (t *Tree[K, V]) Height() int {
+	height, _ := t.balancedHeight(t.Root)
+	return height
+}
+
+// IsBalanced determines whether, at every node of the tree, the heights of the left and right subtrees differ by no
+// more than one.  An empty tree is balanced.
+func (t *Tree[K, V]) IsBalanced() bool {
+	_, balanced := t.balancedHeight(t.Root)
+	return balanced
+}
+
+// Keys provides each of the keys in the tree, in ascending order.
+func (t *Tree[K, V]) Keys() []K {
+	var results []K
+	t.each(t.Root, func(n *node[K, V]) {
+		results = append(results, n.Key)
+	})
+	return results
+}
+
+// Length provides the number of entries in the tree.
+func (t *Tree[K, V]) Length() int {
+	return t.size
+}
+
+// Put stores the value against the key, replacing any value already stored against it.
+func (t *Tree[K, V]) Put(key K, value V) {
+	link := &t.Root
+	for *link != nil {
+		switch {
+		case key < (*link).Key:
+			link = &(*link).Left
+		case key > (*link).Key:
+			link = &(*link).Right
+		default:
+			(*link).Value = value
+			return
+		}
+	}
+	*link = &node[K, V]{Key: key, Value: value}
+	t.size++
+}
+
+// RemoveIfInPlace removes every entry for which the given function returns true, returning how many were removed.  The
+// entries which are kept are rebuilt into a balanced tree, so this takes O(n) time however many entries are removed.
+func (t *Tree[K, V]) RemoveIfInPlace(fn func(key K, value V) bool) int {
+	var kept []Pair[K, V]
+	t.each(t.Root, func(n *node[K, V]) {
+		if !fn(n.Key, n.Value) {
+			kept = append(kept, Pair[K, V]{Key: n.Key, Value: n.Value})
+		}
+	})
+	removed := t.size - len(kept)
+	if removed > 0 {
+		t.Root = buildBalanced(kept)
+		t.size = len(kept)
+	}
+	return removed
+}
+
+// ordered marks the tree as visiting its entries in key order.
+func (t *Tree[K, V]) ordered() {}
+
+// each visits every node beneath the given node in key order.
+func (t *Tree[K, V]) each(n *node[K, V], fn func(n *node[K, V])) {
+	if n == nil {
 		return
 	}
-	//t.Root = put(t.Root, key, value)
+	t.each(n.Left, fn)
+	fn(n)
+	t.each(n.Right, fn)
+}
+
+// balancedHeight provides the height of the subtree beneath the given node, along with whether that subtree is
+// balanced, in a single pass.
+func (t *Tree[K, V]) balancedHeight(n *node[K, V]) (int, bool) {
+	if n == nil {
+		return 0, true
+	}
+	leftHeight, leftBalanced := t.balancedHeight(n.Left)
+	rightHeight, rightBalanced := t.balancedHeight(n.Right)
+	height := leftHeight + 1
+	if rightHeight > leftHeight {
+		height = rightHeight + 1
+	}
+	diff := leftHeight - rightHeight
+	return height, leftBalanced && rightBalanced && diff >= -1 && diff <= 1
 }
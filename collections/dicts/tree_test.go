@@ -0,0 +1,344 @@
+package dicts_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/dicts"
+	"github.com/pickeringtech/go-collections/constraints"
+	"reflect"
+	"testing"
+)
+
+func ExampleNewTreeFromSorted() {
+	t := dicts.NewTreeFromSorted([]dicts.Pair[string, int]{
+		{Key: "a", Value: 1},
+		{Key: "b", Value: 2},
+		{Key: "c", Value: 3},
+	})
+
+	value, ok := t.Get("c")
+	fmt.Printf("keys: %v, root: %v, c: %v, found: %v", t.Keys(), t.Root.Key, value, ok)
+	// Output: keys: [a b c], root: b, c: 3, found: true
+}
+
+func TestNewTreeFromSorted(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name      string
+		entries   []dicts.Pair[K, V]
+		wantKeys  []K
+		wantLevel [][]K
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "builds a balanced tree from sorted entries",
+			entries: []dicts.Pair[int, int]{
+				{Key: 1, Value: 10}, {Key: 2, Value: 20}, {Key: 3, Value: 30}, {Key: 4, Value: 40},
+				{Key: 5, Value: 50}, {Key: 6, Value: 60}, {Key: 7, Value: 70},
+			},
+			wantKeys:  []int{1, 2, 3, 4, 5, 6, 7},
+			wantLevel: [][]int{{4}, {2, 6}, {1, 3, 5, 7}},
+		},
+		{
+			name: "builds a balanced tree from an even number of entries",
+			entries: []dicts.Pair[int, int]{
+				{Key: 1, Value: 10}, {Key: 2, Value: 20}, {Key: 3, Value: 30}, {Key: 4, Value: 40},
+			},
+			wantKeys:  []int{1, 2, 3, 4},
+			wantLevel: [][]int{{3}, {2, 4}, {1}},
+		},
+		{
+			name:      "no entries provides an empty tree",
+			entries:   nil,
+			wantKeys:  nil,
+			wantLevel: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := dicts.NewTreeFromSorted(tt.entries)
+			if got := tree.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			for _, entry := range tt.entries {
+				if value, ok := tree.Get(entry.Key); !ok || value != entry.Value {
+					t.Errorf("Get(%v) = %v, %v, want %v, true", entry.Key, value, ok, entry.Value)
+				}
+			}
+			if tree.Length() != len(tt.entries) {
+				t.Errorf("Length() = %v, want %v", tree.Length(), len(tt.entries))
+			}
+			if got := treeLevels(tree); !reflect.DeepEqual(got, tt.wantLevel) {
+				t.Errorf("NewTreeFromSorted() levels = %v, want %v", got, tt.wantLevel)
+			}
+			if !tree.IsBalanced() {
+				t.Errorf("IsBalanced() = false, want true")
+			}
+		})
+	}
+}
+
+func ExampleTree_Height() {
+	entries := []dicts.Pair[int, string]{
+		{Key: 1, Value: "one"},
+		{Key: 2, Value: "two"},
+		{Key: 3, Value: "three"},
+	}
+	inserted := dicts.NewTree(entries...)
+	sorted := dicts.NewTreeFromSorted(entries)
+
+	fmt.Printf("inserted: %v (balanced: %v), from sorted: %v (balanced: %v)",
+		inserted.Height(), inserted.IsBalanced(), sorted.Height(), sorted.IsBalanced())
+	// Output: inserted: 3 (balanced: false), from sorted: 2 (balanced: true)
+}
+
+func TestTree_HeightIsBalanced(t *testing.T) {
+	type testCase[K constraints.Ordered] struct {
+		name         string
+		keys         []K
+		wantHeight   int
+		wantBalanced bool
+	}
+	tests := []testCase[int]{
+		{
+			name:         "empty tree has no height",
+			keys:         nil,
+			wantHeight:   0,
+			wantBalanced: true,
+		},
+		{
+			name:         "single entry has a height of one",
+			keys:         []int{1},
+			wantHeight:   1,
+			wantBalanced: true,
+		},
+		{
+			name:         "ascending keys form an unbalanced chain",
+			keys:         []int{1, 2, 3, 4, 5},
+			wantHeight:   5,
+			wantBalanced: false,
+		},
+		{
+			name:         "subtrees differing in height by one are balanced",
+			keys:         []int{3, 2, 4, 1},
+			wantHeight:   3,
+			wantBalanced: true,
+		},
+		{
+			name:         "unbalanced subtree below the root is detected",
+			keys:         []int{5, 3, 8, 2, 7, 9, 1},
+			wantHeight:   4,
+			wantBalanced: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := dicts.NewTree[int, int]()
+			for _, key := range tt.keys {
+				tree.Put(key, key)
+			}
+			if got := tree.Height(); got != tt.wantHeight {
+				t.Errorf("Height() = %v, want %v", got, tt.wantHeight)
+			}
+			if got := tree.IsBalanced(); got != tt.wantBalanced {
+				t.Errorf("IsBalanced() = %v, want %v", got, tt.wantBalanced)
+			}
+		})
+	}
+}
+
+func ExampleTree_Put() {
+	t := dicts.NewTree[string, int]()
+	t.Put("b", 2)
+	t.Put("a", 1)
+	t.Put("b", 20)
+
+	value, ok := t.Get("b")
+	fmt.Printf("keys: %v, length: %v, b: %v, found: %v", t.Keys(), t.Length(), value, ok)
+	// Output: keys: [a b], length: 2, b: 20, found: true
+}
+
+func TestTree_Put(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name       string
+		entries    []dicts.Pair[K, V]
+		wantKeys   []K
+		wantValues []V
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "stores entries in key order",
+			entries: []dicts.Pair[int, string]{
+				{Key: 5, Value: "five"},
+				{Key: 1, Value: "one"},
+				{Key: 9, Value: "nine"},
+				{Key: 3, Value: "three"},
+			},
+			wantKeys:   []int{1, 3, 5, 9},
+			wantValues: []string{"one", "three", "five", "nine"},
+		},
+		{
+			name: "replaces the value of an existing key",
+			entries: []dicts.Pair[int, string]{
+				{Key: 1, Value: "one"},
+				{Key: 1, Value: "uno"},
+			},
+			wantKeys:   []int{1},
+			wantValues: []string{"uno"},
+		},
+		{
+			name:       "no entries provides nil keys",
+			entries:    nil,
+			wantKeys:   nil,
+			wantValues: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := dicts.NewTree[int, string]()
+			for _, entry := range tt.entries {
+				tree.Put(entry.Key, entry.Value)
+			}
+			gotKeys := tree.Keys()
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", gotKeys, tt.wantKeys)
+			}
+			var gotValues []string
+			for _, key := range gotKeys {
+				value, _ := tree.Get(key)
+				gotValues = append(gotValues, value)
+			}
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("Get() values = %v, want %v", gotValues, tt.wantValues)
+			}
+			if tree.Length() != len(tt.wantKeys) {
+				t.Errorf("Length() = %v, want %v", tree.Length(), len(tt.wantKeys))
+			}
+		})
+	}
+}
+
+func TestTree_Get(t *testing.T) {
+	tree := dicts.NewTree(dicts.Pair[int, string]{Key: 2, Value: "two"}, dicts.Pair[int, string]{Key: 1, Value: "one"})
+	tests := []struct {
+		name      string
+		key       int
+		wantValue string
+		wantOk    bool
+	}{
+		{
+			name:      "finds a stored key",
+			key:       1,
+			wantValue: "one",
+			wantOk:    true,
+		},
+		{
+			name:      "missing key provides zero value and false",
+			key:       3,
+			wantValue: "",
+			wantOk:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotOk := tree.Get(tt.key)
+			if gotValue != tt.wantValue {
+				t.Errorf("Get() gotValue = %v, want %v", gotValue, tt.wantValue)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("Get() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTree_ForEach(t *testing.T) {
+	tree := dicts.NewTree(
+		dicts.Pair[int, string]{Key: 3, Value: "three"},
+		dicts.Pair[int, string]{Key: 1, Value: "one"},
+		dicts.Pair[int, string]{Key: 2, Value: "two"},
+	)
+	var got []dicts.Pair[int, string]
+	tree.ForEach(func(key int, value string) {
+		got = append(got, dicts.Pair[int, string]{Key: key, Value: value})
+	})
+	want := []dicts.Pair[int, string]{{Key: 1, Value: "one"}, {Key: 2, Value: "two"}, {Key: 3, Value: "three"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ForEach() visited %v, want %v", got, want)
+	}
+}
+
+// treeLevels provides the keys of the tree, grouped by their depth, from the root downwards.
+func treeLevels[K constraints.Ordered, V any](tree *dicts.Tree[K, V]) [][]K {
+	var levels [][]K
+	level := []*dicts.Tree[K, V]{{Root: tree.Root}}
+	for len(level) > 0 {
+		var keys []K
+		var next []*dicts.Tree[K, V]
+		for _, subtree := range level {
+			if subtree.Root == nil {
+				continue
+			}
+			keys = append(keys, subtree.Root.Key)
+			next = append(next, &dicts.Tree[K, V]{Root: subtree.Root.Left}, &dicts.Tree[K, V]{Root: subtree.Root.Right})
+		}
+		if keys != nil {
+			levels = append(levels, keys)
+		}
+		level = next
+	}
+	return levels
+}
+
+func TestTree_RemoveIfInPlace(t *testing.T) {
+	isEven := func(key int, value int) bool {
+		return key%2 == 0
+	}
+	type testCase[K comparable, V any] struct {
+		name      string
+		entries   []dicts.Pair[K, V]
+		want      int
+		wantKeys  []K
+		wantLevel [][]K
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "removes matching entries and rebalances",
+			entries: []dicts.Pair[int, int]{
+				{Key: 1}, {Key: 2}, {Key: 3}, {Key: 4}, {Key: 5}, {Key: 6}, {Key: 7},
+			},
+			want:      3,
+			wantKeys:  []int{1, 3, 5, 7},
+			wantLevel: [][]int{{5}, {3, 7}, {1}},
+		},
+		{
+			name:      "no matching entries removes nothing",
+			entries:   []dicts.Pair[int, int]{{Key: 1}, {Key: 3}},
+			want:      0,
+			wantKeys:  []int{1, 3},
+			wantLevel: [][]int{{1}, {3}},
+		},
+		{
+			name:      "removing every entry empties the tree",
+			entries:   []dicts.Pair[int, int]{{Key: 2}, {Key: 4}},
+			want:      2,
+			wantKeys:  nil,
+			wantLevel: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := dicts.NewTree(tt.entries...)
+			if got := tree.RemoveIfInPlace(isEven); got != tt.want {
+				t.Errorf("RemoveIfInPlace() = %v, want %v", got, tt.want)
+			}
+			if got := tree.Keys(); !reflect.DeepEqual(got, tt.wantKeys) {
+				t.Errorf("Keys() = %v, want %v", got, tt.wantKeys)
+			}
+			if tree.Length() != len(tt.wantKeys) {
+				t.Errorf("Length() = %v, want %v", tree.Length(), len(tt.wantKeys))
+			}
+			if got := treeLevels(tree); !reflect.DeepEqual(got, tt.wantLevel) {
+				t.Errorf("RemoveIfInPlace() levels = %v, want %v", got, tt.wantLevel)
+			}
+		})
+	}
+}
@@ -24,6 +24,10 @@ func (a *Array[T]) AnyMatch(fn func(T) bool) bool {
 	return slices.AnyMatch(a.elements, fn)
 }
 
+func (a *Array[T]) Clone() *Array[T] {
+	return NewArray(append([]T(nil), a.elements...)...)
+}
+
 func (a *Array[T]) Dequeue() (T, bool, []T) {
 	return slices.PopFront(a.elements)
 }
@@ -46,8 +50,9 @@ func (a *Array[T]) Filter(fn func(T) bool) []T {
 	return slices.Filter(a.elements, fn)
 }
 
-func (a *Array[T]) FilterInPlace(fn func(T) bool) {
+func (a *Array[T]) FilterInPlace(fn func(T) bool) MutableList[T] {
 	a.elements = slices.Filter(a.elements, fn)
+	return a
 }
 
 func (a *Array[T]) Find(fn func(T) bool) (T, bool) {
@@ -102,6 +107,12 @@ func (a *Array[T]) Pop() (T, bool, []T) {
 	return slices.Pop(a.elements)
 }
 
+// PopFrontInPlace removes the first element of the array, returning it along with whether there was an element to
+// remove.  This is the same as DequeueInPlace.
+func (a *Array[T]) PopFrontInPlace() (T, bool) {
+	return a.DequeueInPlace()
+}
+
 func (a *Array[T]) PopInPlace() (T, bool) {
 	res, ok, newSli := slices.Pop(a.elements)
 	a.elements = newSli
@@ -112,6 +123,12 @@ func (a *Array[T]) Push(element T) []T {
 	return slices.Push(a.elements, element)
 }
 
+// PushFrontInPlace adds the elements to the start of the array, in the order given.  Every existing element is moved
+// along to make room, so this takes O(n) time.
+func (a *Array[T]) PushFrontInPlace(elements ...T) {
+	a.elements = slices.PushFront(a.elements, slices.Copy(elements)...)
+}
+
 func (a *Array[T]) PushInPlace(element T) {
 	a.elements = slices.Push(a.elements, element)
 }
@@ -169,6 +169,48 @@ func TestArray_AnyMatch(t *testing.T) {
 	}
 }
 
+func ExampleArray_Clone() {
+	working := lists.NewArray(1, 2, 3)
+
+	snapshot := working.Clone()
+	working.PushInPlace(4)
+
+	fmt.Printf("working: %v, snapshot: %v", working.GetAsSlice(), snapshot.GetAsSlice())
+	// Output: working: [1 2 3 4], snapshot: [1 2 3]
+}
+
+func TestArray_Clone(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		a    *lists.Array[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "clones every element",
+			a:    lists.NewArray(1, 2, 3),
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "clones an empty list",
+			a:    lists.NewArray[int](),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Clone()
+			if !reflect.DeepEqual(got.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() = %v, want %v", got.GetAsSlice(), tt.want)
+			}
+			got.PushInPlace(4)
+			if !reflect.DeepEqual(tt.a.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() shares elements with the original, original is now %v", tt.a.GetAsSlice())
+			}
+		})
+	}
+}
+
 func ExampleArray_Dequeue() {
 	arr := lists.NewArray(1, 2, 3, 4, 5)
 
@@ -420,6 +462,19 @@ func TestArray_Filter(t *testing.T) {
 	}
 }
 
+func ExampleArray_FilterInPlace() {
+	a := lists.NewArray(5, 1, 4, 2, 3)
+
+	a.FilterInPlace(func(i int) bool {
+		return i > 1
+	}).SortInPlace(func(a, b int) bool {
+		return a < b
+	})
+
+	fmt.Printf("elements: %v", a.GetAsSlice())
+	// Output: elements: [2 3 4 5]
+}
+
 func TestArray_FilterInPlace(t *testing.T) {
 	type args[T any] struct {
 		fn func(T) bool
@@ -454,12 +509,15 @@ func TestArray_FilterInPlace(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.a.FilterInPlace(tt.args.fn)
+			returned := tt.a.FilterInPlace(tt.args.fn)
 
 			got := tt.a.GetAsSlice()
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("FilterInPlace() = %v, want %v", got, tt.want)
 			}
+			if returned != tt.a {
+				t.Errorf("FilterInPlace() returned %p, want the receiver %p", returned, tt.a)
+			}
 		})
 	}
 }
@@ -1263,6 +1321,95 @@ func TestArray_Push(t *testing.T) {
 	}
 }
 
+func ExampleArray_PushFrontInPlace() {
+	buffer := lists.NewArray(2, 1)
+
+	// Add the newest reading to the front, evicting the oldest from the back.
+	buffer.PushFrontInPlace(3)
+	evicted, _ := buffer.PopInPlace()
+
+	fmt.Printf("buffer: %v, evicted: %v", buffer.GetAsSlice(), evicted)
+	// Output: buffer: [3 2], evicted: 1
+}
+
+func TestArray_PushFrontInPlace(t *testing.T) {
+	type args[T any] struct {
+		elements []T
+	}
+	type testCase[T any] struct {
+		name string
+		a    *lists.Array[T]
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "adds elements to the start in the order given",
+			a:    lists.NewArray(3, 4),
+			args: args[int]{
+				elements: []int{1, 2},
+			},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "adds elements to an empty array",
+			a:    lists.NewArray[int](),
+			args: args[int]{
+				elements: []int{1},
+			},
+			want: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.a.PushFrontInPlace(tt.args.elements...)
+			if got := tt.a.GetAsSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushFrontInPlace() resulted in %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestArray_PopFrontInPlace(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		a         *lists.Array[T]
+		want      T
+		wantOk    bool
+		wantAfter []T
+	}
+	tests := []testCase[int]{
+		{
+			name:      "removes the first element",
+			a:         lists.NewArray(1, 2, 3),
+			want:      1,
+			wantOk:    true,
+			wantAfter: []int{2, 3},
+		},
+		{
+			name:      "empty array has nothing to remove",
+			a:         lists.NewArray[int](),
+			want:      0,
+			wantOk:    false,
+			wantAfter: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := tt.a.PopFrontInPlace()
+			if got != tt.want {
+				t.Errorf("PopFrontInPlace() got = %v, want %v", got, tt.want)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("PopFrontInPlace() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+			if after := tt.a.GetAsSlice(); !reflect.DeepEqual(after, tt.wantAfter) {
+				t.Errorf("PopFrontInPlace() resulted in %v, want %v", after, tt.wantAfter)
+			}
+		})
+	}
+}
+
 func TestArray_PushInPlace(t *testing.T) {
 	type args[T any] struct {
 		element T
@@ -35,6 +35,13 @@ func (a *ConcurrentArray[T]) AnyMatch(fun func(T) bool) bool {
 	return slices.AnyMatch(a.elements, fun)
 }
 
+func (a *ConcurrentArray[T]) Clone() *ConcurrentArray[T] {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	return NewConcurrentArray(append([]T(nil), a.elements...)...)
+}
+
 func (a *ConcurrentArray[T]) Dequeue() (T, bool, []T) {
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -72,11 +79,12 @@ func (a *ConcurrentArray[T]) Filter(fun func(T) bool) []T {
 	return slices.Filter(a.elements, fun)
 }
 
-func (a *ConcurrentArray[T]) FilterInPlace(fn func(T) bool) {
+func (a *ConcurrentArray[T]) FilterInPlace(fn func(T) bool) MutableList[T] {
 	a.lock.Lock()
 	defer a.lock.Unlock()
 
 	a.elements = slices.Filter(a.elements, fn)
+	return a
 }
 
 func (a *ConcurrentArray[T]) Find(fun func(T) bool) (T, bool) {
@@ -167,6 +175,21 @@ func (a *ConcurrentArray[T]) Pop() (T, bool, []T) {
 	return slices.Pop(a.elements)
 }
 
+// PopFrontInPlace removes the first element of the array, returning it along with whether there was an element to
+// remove.  This is the same as DequeueInPlace.
+func (a *ConcurrentArray[T]) PopFrontInPlace() (T, bool) {
+	return a.DequeueInPlace()
+}
+
+// PushFrontInPlace adds the elements to the start of the array, in the order given.  Every existing element is moved
+// along to make room, so this takes O(n) time.
+func (a *ConcurrentArray[T]) PushFrontInPlace(elements ...T) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.elements = slices.PushFront(a.elements, slices.Copy(elements)...)
+}
+
 func (a *ConcurrentArray[T]) PopInPlace() (T, bool) {
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -203,3 +226,16 @@ func (a *ConcurrentArray[T]) SortInPlace(lessThan func(T, T) bool) {
 
 	slices.SortInPlace(a.elements, lessThan)
 }
+
+// WithLock calls the given function while holding the lock, giving it exclusive access to the elements of the array
+// through a MutableList handle, so that a sequence of operations - such as peeking at the front and then conditionally
+// dequeuing - happens atomically.  The handle is not itself locked, and must not be used or retained once the function
+// returns, nor may the function call methods on the array itself, as the lock is already held.
+func (a *ConcurrentArray[T]) WithLock(fn func(mutable MutableList[T])) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	handle := &Array[T]{elements: a.elements}
+	fn(handle)
+	a.elements = handle.elements
+}
@@ -10,11 +10,12 @@ type ConcurrentRWArray[T any] struct {
 	lock     *sync.RWMutex
 }
 
-func (a *ConcurrentRWArray[T]) FilterInPlace(fn func(T) bool) {
+func (a *ConcurrentRWArray[T]) FilterInPlace(fn func(T) bool) MutableList[T] {
 	a.lock.Lock()
 	defer a.lock.Unlock()
 
 	a.elements = slices.Filter(a.elements, fn)
+	return a
 }
 
 func (a *ConcurrentRWArray[T]) InsertInPlace(index int, element ...T) {
@@ -31,6 +32,21 @@ func (a *ConcurrentRWArray[T]) PushInPlace(element T) {
 	a.elements = slices.Push(a.elements, element)
 }
 
+// PopFrontInPlace removes the first element of the array, returning it along with whether there was an element to
+// remove.  This is the same as DequeueInPlace.
+func (a *ConcurrentRWArray[T]) PopFrontInPlace() (T, bool) {
+	return a.DequeueInPlace()
+}
+
+// PushFrontInPlace adds the elements to the start of the array, in the order given.  Every existing element is moved
+// along to make room, so this takes O(n) time.
+func (a *ConcurrentRWArray[T]) PushFrontInPlace(elements ...T) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	a.elements = slices.PushFront(a.elements, slices.Copy(elements)...)
+}
+
 func (a *ConcurrentRWArray[T]) PopInPlace() (T, bool) {
 	a.lock.Lock()
 	defer a.lock.Unlock()
@@ -86,6 +102,13 @@ func (a *ConcurrentRWArray[T]) AnyMatch(fun func(T) bool) bool {
 	return slices.AnyMatch(a.elements, fun)
 }
 
+func (a *ConcurrentRWArray[T]) Clone() *ConcurrentRWArray[T] {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	return NewConcurrentRWArray(append([]T(nil), a.elements...)...)
+}
+
 func (a *ConcurrentRWArray[T]) Dequeue() (T, bool, []T) {
 	a.lock.RLock()
 	defer a.lock.RUnlock()
@@ -208,3 +231,16 @@ func (a *ConcurrentRWArray[T]) SortInPlace(lessThan func(T, T) bool) {
 
 	slices.SortInPlace(a.elements, lessThan)
 }
+
+// WithLock calls the given function while holding the lock, giving it exclusive access to the elements of the array
+// through a MutableList handle, so that a sequence of operations - such as peeking at the front and then conditionally
+// dequeuing - happens atomically.  The handle is not itself locked, and must not be used or retained once the function
+// returns, nor may the function call methods on the array itself, as the lock is already held.
+func (a *ConcurrentRWArray[T]) WithLock(fn func(mutable MutableList[T])) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	handle := &Array[T]{elements: a.elements}
+	fn(handle)
+	a.elements = handle.elements
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/pickeringtech/go-collections/maps"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -183,6 +184,38 @@ func ExampleConcurrentRWArray_Dequeue() {
 	// Rest: [2 3 4 5]
 }
 
+func TestConcurrentRWArray_Clone(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		a    *lists.ConcurrentRWArray[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "clones every element",
+			a:    lists.NewConcurrentRWArray(1, 2, 3),
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "clones an empty list",
+			a:    lists.NewConcurrentRWArray[int](),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.a.Clone()
+			if !reflect.DeepEqual(got.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() = %v, want %v", got.GetAsSlice(), tt.want)
+			}
+			got.PushInPlace(4)
+			if !reflect.DeepEqual(tt.a.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() shares elements with the original, original is now %v", tt.a.GetAsSlice())
+			}
+		})
+	}
+}
+
 func TestConcurrentRWArray_Dequeue(t *testing.T) {
 	type testCase[T any] struct {
 		name    string
@@ -454,12 +487,15 @@ func TestConcurrentRWArray_FilterInPlace(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			tt.a.FilterInPlace(tt.args.fn)
+			returned := tt.a.FilterInPlace(tt.args.fn)
 
 			got := tt.a.GetAsSlice()
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("FilterInPlace() = %v, want %v", got, tt.want)
 			}
+			if returned != tt.a {
+				t.Errorf("FilterInPlace() returned %p, want the receiver %p", returned, tt.a)
+			}
 		})
 	}
 }
@@ -1263,6 +1299,84 @@ func TestConcurrentRWArray_Push(t *testing.T) {
 	}
 }
 
+func TestConcurrentRWArray_PushFrontInPlace(t *testing.T) {
+	type args[T any] struct {
+		elements []T
+	}
+	type testCase[T any] struct {
+		name string
+		a    *lists.ConcurrentRWArray[T]
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "adds elements to the start in the order given",
+			a:    lists.NewConcurrentRWArray(3, 4),
+			args: args[int]{
+				elements: []int{1, 2},
+			},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "adds elements to an empty array",
+			a:    lists.NewConcurrentRWArray[int](),
+			args: args[int]{
+				elements: []int{1},
+			},
+			want: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.a.PushFrontInPlace(tt.args.elements...)
+			if got := tt.a.GetAsSlice(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushFrontInPlace() resulted in %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConcurrentRWArray_PopFrontInPlace(t *testing.T) {
+	type testCase[T any] struct {
+		name      string
+		a         *lists.ConcurrentRWArray[T]
+		want      T
+		wantOk    bool
+		wantAfter []T
+	}
+	tests := []testCase[int]{
+		{
+			name:      "removes the first element",
+			a:         lists.NewConcurrentRWArray(1, 2, 3),
+			want:      1,
+			wantOk:    true,
+			wantAfter: []int{2, 3},
+		},
+		{
+			name:      "empty array has nothing to remove",
+			a:         lists.NewConcurrentRWArray[int](),
+			want:      0,
+			wantOk:    false,
+			wantAfter: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := tt.a.PopFrontInPlace()
+			if got != tt.want {
+				t.Errorf("PopFrontInPlace() got = %v, want %v", got, tt.want)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("PopFrontInPlace() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+			if after := tt.a.GetAsSlice(); !reflect.DeepEqual(after, tt.wantAfter) {
+				t.Errorf("PopFrontInPlace() resulted in %v, want %v", after, tt.wantAfter)
+			}
+		})
+	}
+}
+
 func TestConcurrentRWArray_PushInPlace(t *testing.T) {
 	type args[T any] struct {
 		element T
@@ -1418,3 +1532,40 @@ func TestConcurrentRWArray_SortInPlace(t *testing.T) {
 		})
 	}
 }
+
+func ExampleConcurrentRWArray_WithLock() {
+	queue := lists.NewConcurrentRWArray("job-1", "job-2", "job-3")
+
+	queue.WithLock(func(mutable lists.MutableList[string]) {
+		if front, ok := mutable.PeekFront(); ok && front == "job-1" {
+			mutable.DequeueInPlace()
+		}
+	})
+
+	fmt.Printf("queue: %v", queue.GetAsSlice())
+	// Output: queue: [job-2 job-3]
+}
+
+func TestConcurrentRWArray_WithLock(t *testing.T) {
+	a := lists.NewConcurrentRWArray[int]()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.WithLock(func(mutable lists.MutableList[int]) {
+				last, _ := mutable.PeekEnd()
+				mutable.PushInPlace(last + 1)
+			})
+		}()
+	}
+	wg.Wait()
+
+	want := slices.Generate(100, func(idx int) int {
+		return idx + 1
+	})
+	if got := a.GetAsSlice(); !reflect.DeepEqual(got, want) {
+		t.Errorf("WithLock() resulted in %v, want %v", got, want)
+	}
+}
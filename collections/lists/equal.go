@@ -0,0 +1,20 @@
+package lists
+
+// Equal determines whether the two lists hold the same elements in the same order.  The lists may be of different
+// implementations - any Iterable can be compared, including a Linked list.  Each list is read in a single iteration,
+// which also counts its elements, so a concurrent list is locked once for the comparison rather than for each element,
+// and the two lists are never locked at the same time.
+func Equal[T comparable](a, b Iterable[T]) bool {
+	var elementsB []T
+	b.ForEach(func(element T) {
+		elementsB = append(elementsB, element)
+	})
+	equal, count := true, 0
+	a.ForEachWithIndex(func(idx int, element T) {
+		count++
+		if equal && (idx >= len(elementsB) || element != elementsB[idx]) {
+			equal = false
+		}
+	})
+	return equal && count == len(elementsB)
+}
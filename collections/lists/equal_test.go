@@ -0,0 +1,101 @@
+package lists_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/lists"
+	"testing"
+)
+
+func ExampleEqual() {
+	expected := lists.NewLinked("a", "b", "c")
+	actual := lists.NewArray("a", "b", "c")
+
+	fmt.Printf("equal: %v", lists.Equal[string](expected, actual))
+	// Output: equal: true
+}
+
+func TestEqual(t *testing.T) {
+	type args[T comparable] struct {
+		a lists.Iterable[T]
+		b lists.Iterable[T]
+	}
+	type testCase[T comparable] struct {
+		name string
+		args args[T]
+		want bool
+	}
+	shared := lists.NewConcurrentArray(1, 2)
+	tests := []testCase[int]{
+		{
+			name: "same elements across implementations are equal",
+			args: args[int]{
+				a: lists.NewArray(1, 2, 3),
+				b: lists.NewConcurrentArray(1, 2, 3),
+			},
+			want: true,
+		},
+		{
+			name: "same elements in a different order are not equal",
+			args: args[int]{
+				a: lists.NewConcurrentRWArray(1, 2, 3),
+				b: lists.NewArray(3, 2, 1),
+			},
+			want: false,
+		},
+		{
+			name: "different lengths are not equal",
+			args: args[int]{
+				a: lists.NewArray(1, 2),
+				b: lists.NewArray(1, 2, 3),
+			},
+			want: false,
+		},
+		{
+			name: "linked list is equal to an array with the same elements",
+			args: args[int]{
+				a: lists.NewLinked(1, 2, 3),
+				b: lists.NewArray(1, 2, 3),
+			},
+			want: true,
+		},
+		{
+			name: "linked list is not equal to a longer array",
+			args: args[int]{
+				a: lists.NewArray(1, 2, 3),
+				b: lists.NewLinked(1, 2),
+			},
+			want: false,
+		},
+		{
+			name: "circular linked list is compared once around",
+			args: args[int]{
+				a: lists.NewLinkedCircular(1, 2, 3),
+				b: lists.NewConcurrentRWArray(1, 2, 3),
+			},
+			want: true,
+		},
+		{
+			name: "empty lists are equal",
+			args: args[int]{
+				a: lists.NewArray[int](),
+				b: lists.NewConcurrentRWArray[int](),
+			},
+			want: true,
+		},
+		{
+			name: "concurrent list is equal to itself",
+			args: args[int]{
+				a: shared,
+				b: shared,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lists.Equal(tt.args.a, tt.args.b); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
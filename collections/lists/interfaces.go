@@ -5,7 +5,7 @@ type Filterable[T any] interface {
 }
 
 type MutableFilterable[T any] interface {
-	FilterInPlace(fn func(T) bool)
+	FilterInPlace(fn func(T) bool) MutableList[T]
 }
 
 type Indexable[T any] interface {
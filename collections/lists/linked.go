@@ -9,9 +9,13 @@ type node[T any] struct {
 type Linked[T any] struct {
 	head       *node[T]
 	tail       *node[T]
+	length     int
 	isCircular bool
 }
 
+// Interface guards
+var _ Iterable[int] = &Linked[int]{}
+
 func NewLinked[T any](values ...T) *Linked[T] {
 	linked := &Linked[T]{}
 
@@ -34,11 +38,50 @@ func NewLinkedCircular[T any](values ...T) *Linked[T] {
 	return linked
 }
 
+// Clone provides an independent copy of the list, with its own nodes holding the same values.  A circular list results
+// in a circular copy.
+func (l *Linked[T]) Clone() *Linked[T] {
+	if l.isCircular {
+		return NewLinkedCircular(l.GetAsSlice()...)
+	}
+	return NewLinked(l.GetAsSlice()...)
+}
+
+// EnqueueInPlace adds the element to the end of the list.  The tail of the list is tracked, so this is O(1) regardless
+// of the length of the list.
+func (l *Linked[T]) EnqueueInPlace(element T) {
+	l.Insert(element)
+}
+
+// ForEach calls the given function with each element of the list, from head to tail.  A circular list is visited once.
+func (l *Linked[T]) ForEach(fn EachFunc[T]) {
+	for n, i := l.head, 0; i < l.length; n, i = n.next, i+1 {
+		fn(n.value)
+	}
+}
+
+// ForEachWithIndex calls the given function with the index and value of each element of the list, from head to tail.
+// A circular list is visited once.
+func (l *Linked[T]) ForEachWithIndex(fn IndexedEachFunc[T]) {
+	for n, i := l.head, 0; i < l.length; n, i = n.next, i+1 {
+		fn(i, n.value)
+	}
+}
+
+// GetAsSlice provides the elements of the list, from head to tail, as a new slice.
+func (l *Linked[T]) GetAsSlice() []T {
+	var results []T
+	for n, i := l.head, 0; i < l.length; n, i = n.next, i+1 {
+		results = append(results, n.value)
+	}
+	return results
+}
+
+// Insert adds the value to the end of the list in O(1) time.
 func (l *Linked[T]) Insert(value T) {
 	newNode := &node[T]{
 		value:  value,
 		linked: l,
-		next:   l.tail,
 	}
 
 	if l.head == nil {
@@ -49,7 +92,20 @@ func (l *Linked[T]) Insert(value T) {
 		l.tail = newNode
 	}
 
+	l.length++
+
 	if l.isCircular {
 		l.tail.next = l.head
 	}
 }
+
+// Length provides the number of elements in the list.
+func (l *Linked[T]) Length() int {
+	return l.length
+}
+
+// PushInPlace adds the element to the end of the list.  The tail of the list is tracked, so this is O(1) regardless of
+// the length of the list.
+func (l *Linked[T]) PushInPlace(element T) {
+	l.Insert(element)
+}
@@ -0,0 +1,176 @@
+package lists_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/lists"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func TestLinked_Clone(t *testing.T) {
+	type testCase[T any] struct {
+		name string
+		l    *lists.Linked[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "clones every element",
+			l:    lists.NewLinked(1, 2, 3),
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "clones a circular list",
+			l:    lists.NewLinkedCircular(1, 2),
+			want: []int{1, 2},
+		},
+		{
+			name: "clones an empty list",
+			l:    lists.NewLinked[int](),
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.l.Clone()
+			if !reflect.DeepEqual(got.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() = %v, want %v", got.GetAsSlice(), tt.want)
+			}
+			got.PushInPlace(4)
+			if !reflect.DeepEqual(tt.l.GetAsSlice(), tt.want) {
+				t.Errorf("Clone() shares nodes with the original, original is now %v", tt.l.GetAsSlice())
+			}
+		})
+	}
+}
+
+func ExampleLinked_EnqueueInPlace() {
+	l := lists.NewLinked(1, 2)
+	l.EnqueueInPlace(3)
+
+	fmt.Printf("elements: %v, length: %v", l.GetAsSlice(), l.Length())
+	// Output: elements: [1 2 3], length: 3
+}
+
+func TestLinked_EnqueueInPlace(t *testing.T) {
+	type args[T any] struct {
+		elements []T
+	}
+	type testCase[T any] struct {
+		name string
+		l    *lists.Linked[T]
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "adds elements to the end of the list",
+			l:    lists.NewLinked(1, 2, 3),
+			args: args[int]{
+				elements: []int{4, 5},
+			},
+			want: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "adds elements to an empty list",
+			l:    lists.NewLinked[int](),
+			args: args[int]{
+				elements: []int{1, 2},
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "adds elements to the end of a circular list",
+			l:    lists.NewLinkedCircular(1, 2),
+			args: args[int]{
+				elements: []int{3},
+			},
+			want: []int{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, element := range tt.args.elements {
+				tt.l.EnqueueInPlace(element)
+			}
+			got := tt.l.GetAsSlice()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnqueueInPlace() resulted in %v, want %v", got, tt.want)
+			}
+			if tt.l.Length() != len(tt.want) {
+				t.Errorf("Length() = %v, want %v", tt.l.Length(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestLinked_PushInPlace(t *testing.T) {
+	type args[T any] struct {
+		element T
+	}
+	type testCase[T any] struct {
+		name string
+		l    *lists.Linked[T]
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "adds the element to the end of the list",
+			l:    lists.NewLinked(1, 2, 3),
+			args: args[int]{
+				element: 4,
+			},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "adds the element to an empty list",
+			l:    lists.NewLinked[int](),
+			args: args[int]{
+				element: 1,
+			},
+			want: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.l.PushInPlace(tt.args.element)
+			got := tt.l.GetAsSlice()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PushInPlace() resulted in %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkLinked_EnqueueInPlace(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		l    *lists.Linked[int]
+	}{
+		{
+			name: "10 elements",
+			l:    lists.NewLinked(slices.Generate(10, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "1_000 elements",
+			l:    lists.NewLinked(slices.Generate(1_000, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "100_000 elements",
+			l:    lists.NewLinked(slices.Generate(100_000, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "1_000_000 elements",
+			l:    lists.NewLinked(slices.Generate(1_000_000, slices.NumericIdentityGenerator[int])...),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				bm.l.EnqueueInPlace(i)
+			}
+		})
+	}
+}
@@ -1,3 +1,55 @@
 package sets
 
-// TODO: Implement concurrent hash set
+import "sync"
+
+// ConcurrentHash is a hash set which is safe for concurrent use, guarded by a single lock.
+type ConcurrentHash[T comparable] struct {
+	elements Hash[T]
+	lock     *sync.Mutex
+}
+
+func NewConcurrentHash[T comparable](values ...T) *ConcurrentHash[T] {
+	return &ConcurrentHash[T]{
+		elements: NewHash(values...),
+		lock:     &sync.Mutex{},
+	}
+}
+
+// Interface guards
+var _ Set[int] = &ConcurrentHash[int]{}
+var _ MutableSet[int] = &ConcurrentHash[int]{}
+
+// AddAllInPlace adds each of the given elements to the set, returning how many of them were not already present.  The
+// lock is held once for the whole batch, so concurrent callers never observe a partially added batch.
+func (h *ConcurrentHash[T]) AddAllInPlace(elements ...T) int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.elements.AddAllInPlace(elements...)
+}
+
+// Contains provides whether the element is present in the set.
+func (h *ConcurrentHash[T]) Contains(element T) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	_, ok := h.elements[element]
+	return ok
+}
+
+// Length provides the number of elements in the set.
+func (h *ConcurrentHash[T]) Length() int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return len(h.elements)
+}
+
+// PopInPlace removes an arbitrary element from the set, returning it along with whether there was an element to remove.
+// The lock is held across the removal, so concurrent callers never pop the same element twice.
+func (h *ConcurrentHash[T]) PopInPlace() (T, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.elements.PopInPlace()
+}
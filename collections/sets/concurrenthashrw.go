@@ -1,3 +1,56 @@
 package sets
 
-// TODO: Implement concurrent hash set with a read-write lock.
+import "sync"
+
+// ConcurrentHashRW is a hash set which is safe for concurrent use, guarded by a read-write lock so that many readers may
+// access the elements at once.
+type ConcurrentHashRW[T comparable] struct {
+	elements Hash[T]
+	lock     *sync.RWMutex
+}
+
+func NewConcurrentHashRW[T comparable](values ...T) *ConcurrentHashRW[T] {
+	return &ConcurrentHashRW[T]{
+		elements: NewHash(values...),
+		lock:     &sync.RWMutex{},
+	}
+}
+
+// Interface guards
+var _ Set[int] = &ConcurrentHashRW[int]{}
+var _ MutableSet[int] = &ConcurrentHashRW[int]{}
+
+// AddAllInPlace adds each of the given elements to the set, returning how many of them were not already present.  The
+// write lock is held once for the whole batch, so readers never observe a partially added batch.
+func (h *ConcurrentHashRW[T]) AddAllInPlace(elements ...T) int {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.elements.AddAllInPlace(elements...)
+}
+
+// Contains provides whether the element is present in the set.
+func (h *ConcurrentHashRW[T]) Contains(element T) bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	_, ok := h.elements[element]
+	return ok
+}
+
+// Length provides the number of elements in the set.
+func (h *ConcurrentHashRW[T]) Length() int {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return len(h.elements)
+}
+
+// PopInPlace removes an arbitrary element from the set, returning it along with whether there was an element to remove.
+// The write lock is held across the removal, so concurrent callers never pop the same element twice.
+func (h *ConcurrentHashRW[T]) PopInPlace() (T, bool) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	return h.elements.PopInPlace()
+}
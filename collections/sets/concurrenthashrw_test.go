@@ -0,0 +1,129 @@
+package sets_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/sets"
+	"sync"
+	"testing"
+)
+
+func ExampleConcurrentHashRW_AddAllInPlace() {
+	seen := sets.NewConcurrentHashRW("alice", "bob")
+
+	added := seen.AddAllInPlace("bob", "carol", "dave", "dave")
+
+	fmt.Printf("added: %v, length: %v", added, seen.Length())
+	// Output: added: 2, length: 4
+}
+
+func TestConcurrentHashRW_AddAllInPlace(t *testing.T) {
+	type args[T comparable] struct {
+		elements []T
+	}
+	type testCase[T comparable] struct {
+		name         string
+		values       []T
+		args         args[T]
+		want         int
+		wantContains []T
+	}
+	tests := []testCase[int]{
+		{
+			name:         "counts only newly added elements",
+			values:       []int{1, 2},
+			args:         args[int]{elements: []int{2, 3, 4}},
+			want:         2,
+			wantContains: []int{1, 2, 3, 4},
+		},
+		{
+			name:         "no elements adds nothing",
+			values:       []int{1},
+			args:         args[int]{elements: nil},
+			want:         0,
+			wantContains: []int{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := sets.NewConcurrentHashRW(tt.values...)
+			if got := h.AddAllInPlace(tt.args.elements...); got != tt.want {
+				t.Errorf("AddAllInPlace() = %v, want %v", got, tt.want)
+			}
+			if got := h.Length(); got != len(tt.wantContains) {
+				t.Errorf("Length() = %v, want %v", got, len(tt.wantContains))
+			}
+			for _, element := range tt.wantContains {
+				if !h.Contains(element) {
+					t.Errorf("Contains(%v) = false, want true", element)
+				}
+			}
+		})
+	}
+}
+
+func TestConcurrentHashRW_ConcurrentAddAllInPlace(t *testing.T) {
+	h := sets.NewConcurrentHashRW[int]()
+	added := make([]int, 4)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			// Every worker loads the same overlapping batch, so exactly one of them adds each element.
+			batch := make([]int, 1_000)
+			for i := range batch {
+				batch[i] = i
+			}
+			added[worker] = h.AddAllInPlace(batch...)
+		}(worker)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, count := range added {
+		total += count
+	}
+	if total != 1_000 {
+		t.Errorf("AddAllInPlace() total = %v, want 1000", total)
+	}
+	if got := h.Length(); got != 1_000 {
+		t.Errorf("Length() = %v, want 1000", got)
+	}
+}
+
+func TestConcurrentHashRW_ConcurrentPopInPlace(t *testing.T) {
+	values := make([]int, 1_000)
+	for i := range values {
+		values[i] = i
+	}
+	h := sets.NewConcurrentHashRW(values...)
+	popped := make([][]int, 4)
+	var wg sync.WaitGroup
+	for worker := 0; worker < 4; worker++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for {
+				element, ok := h.PopInPlace()
+				if !ok {
+					return
+				}
+				popped[worker] = append(popped[worker], element)
+			}
+		}(worker)
+	}
+	wg.Wait()
+
+	seen := sets.NewHash[int]()
+	for _, elements := range popped {
+		if added := seen.AddAllInPlace(elements...); added != len(elements) {
+			t.Errorf("PopInPlace() provided %v elements more than once", len(elements)-added)
+		}
+	}
+	if len(seen) != 1_000 {
+		t.Errorf("PopInPlace() provided %v distinct elements, want 1000", len(seen))
+	}
+	if got := h.Length(); got != 0 {
+		t.Errorf("Length() = %v, want 0", got)
+	}
+}
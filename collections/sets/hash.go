@@ -9,3 +9,47 @@ func NewHash[T comparable](values ...T) Hash[T] {
 	}
 	return m
 }
+
+// Interface guards
+var _ MutableSet[int] = Hash[int]{}
+
+// AddAllInPlace adds each of the given elements to the set, returning how many of them were not already present.
+func (h Hash[T]) AddAllInPlace(elements ...T) int {
+	added := 0
+	for _, element := range elements {
+		if _, ok := h[element]; ok {
+			continue
+		}
+		h[element] = struct{}{}
+		added++
+	}
+	return added
+}
+
+// Intersection provides a new set holding the elements which are present in both this set and the other set.  The
+// smaller of the two sets is iterated, probing the larger one, so this takes time proportional to the size of the
+// smaller set.
+func (h Hash[T]) Intersection(other Hash[T]) Hash[T] {
+	smaller, larger := h, other
+	if len(larger) < len(smaller) {
+		smaller, larger = larger, smaller
+	}
+	results := make(Hash[T])
+	for element := range smaller {
+		if _, ok := larger[element]; ok {
+			results[element] = struct{}{}
+		}
+	}
+	return results
+}
+
+// PopInPlace removes an arbitrary element from the set, returning it along with whether there was an element to remove.
+// No particular element is favoured, so repeated calls may return the elements in any order.
+func (h Hash[T]) PopInPlace() (T, bool) {
+	for element := range h {
+		delete(h, element)
+		return element, true
+	}
+	var zero T
+	return zero, false
+}
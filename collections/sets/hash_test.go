@@ -0,0 +1,245 @@
+package sets_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/collections/sets"
+	"reflect"
+	"testing"
+)
+
+func ExampleHash_AddAllInPlace() {
+	h := sets.NewHash(1, 2)
+
+	added := h.AddAllInPlace(2, 3, 4, 4)
+
+	fmt.Printf("added: %v, set: %v", added, h)
+	// Output: added: 2, set: map[1:{} 2:{} 3:{} 4:{}]
+}
+
+func TestHash_AddAllInPlace(t *testing.T) {
+	type args[T comparable] struct {
+		elements []T
+	}
+	type testCase[T comparable] struct {
+		name    string
+		h       sets.Hash[T]
+		args    args[T]
+		want    int
+		wantSet sets.Hash[T]
+	}
+	tests := []testCase[int]{
+		{
+			name: "counts only newly added elements",
+			h:    sets.NewHash(1, 2),
+			args: args[int]{
+				elements: []int{2, 3, 4},
+			},
+			want:    2,
+			wantSet: sets.NewHash(1, 2, 3, 4),
+		},
+		{
+			name: "repeated elements are counted once",
+			h:    sets.NewHash[int](),
+			args: args[int]{
+				elements: []int{1, 1, 1},
+			},
+			want:    1,
+			wantSet: sets.NewHash(1),
+		},
+		{
+			name: "already present elements add nothing",
+			h:    sets.NewHash(1, 2),
+			args: args[int]{
+				elements: []int{1, 2},
+			},
+			want:    0,
+			wantSet: sets.NewHash(1, 2),
+		},
+		{
+			name: "no elements add nothing",
+			h:    sets.NewHash(1),
+			args: args[int]{
+				elements: nil,
+			},
+			want:    0,
+			wantSet: sets.NewHash(1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.h.AddAllInPlace(tt.args.elements...)
+			if got != tt.want {
+				t.Errorf("AddAllInPlace() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.h, tt.wantSet) {
+				t.Errorf("AddAllInPlace() resulted in %v, want %v", tt.h, tt.wantSet)
+			}
+		})
+	}
+}
+
+func ExampleHash_Intersection() {
+	requested := sets.NewHash("read", "write", "admin")
+	granted := sets.NewHash("read", "write", "delete")
+
+	allowed := requested.Intersection(granted)
+
+	fmt.Printf("allowed: %v", allowed)
+	// Output: allowed: map[read:{} write:{}]
+}
+
+func TestHash_Intersection(t *testing.T) {
+	type testCase[T comparable] struct {
+		name  string
+		h     sets.Hash[T]
+		other sets.Hash[T]
+		want  sets.Hash[T]
+	}
+	tests := []testCase[int]{
+		{
+			name:  "keeps elements present in both sets",
+			h:     sets.NewHash(1, 2, 3, 4),
+			other: sets.NewHash(3, 4, 5),
+			want:  sets.NewHash(3, 4),
+		},
+		{
+			name:  "smaller receiver gives the same result",
+			h:     sets.NewHash(3, 4, 5),
+			other: sets.NewHash(1, 2, 3, 4),
+			want:  sets.NewHash(3, 4),
+		},
+		{
+			name:  "disjoint sets provide an empty set",
+			h:     sets.NewHash(1, 2),
+			other: sets.NewHash(3, 4),
+			want:  sets.NewHash[int](),
+		},
+		{
+			name:  "nil other set provides an empty set",
+			h:     sets.NewHash(1, 2),
+			other: nil,
+			want:  sets.NewHash[int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.h.Intersection(tt.other)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Intersection() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkHash_Intersection(b *testing.B) {
+	small := sets.NewHash[int]()
+	for i := 0; i < 10; i++ {
+		small[i*1_000] = struct{}{}
+	}
+	large := sets.NewHash[int]()
+	for i := 0; i < 1_000_000; i++ {
+		large[i] = struct{}{}
+	}
+	benchmarks := []struct {
+		name  string
+		h     sets.Hash[int]
+		other sets.Hash[int]
+	}{
+		{
+			name:  "small receiver, large other",
+			h:     small,
+			other: large,
+		},
+		{
+			name:  "large receiver, small other",
+			h:     large,
+			other: small,
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = bm.h.Intersection(bm.other)
+			}
+		})
+	}
+}
+
+func ExampleHash_PopInPlace() {
+	edges := map[string][]string{
+		"a": {"b", "c"},
+		"b": {"d"},
+		"c": {"d"},
+	}
+
+	visited := sets.NewHash[string]()
+	worklist := sets.NewHash("a")
+	for len(worklist) > 0 {
+		node, _ := worklist.PopInPlace()
+		visited.AddAllInPlace(node)
+		for _, next := range edges[node] {
+			if _, seen := visited[next]; !seen {
+				worklist.AddAllInPlace(next)
+			}
+		}
+	}
+
+	fmt.Printf("visited: %v", len(visited))
+	// Output: visited: 4
+}
+
+func TestHash_PopInPlace(t *testing.T) {
+	type testCase[T comparable] struct {
+		name    string
+		h       sets.Hash[T]
+		wantOk  bool
+		wantLen int
+	}
+	tests := []testCase[int]{
+		{
+			name:    "removes and provides an element",
+			h:       sets.NewHash(1, 2, 3),
+			wantOk:  true,
+			wantLen: 2,
+		},
+		{
+			name:    "single element empties the set",
+			h:       sets.NewHash(1),
+			wantOk:  true,
+			wantLen: 0,
+		},
+		{
+			name:    "empty set provides nothing",
+			h:       sets.NewHash[int](),
+			wantOk:  false,
+			wantLen: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := sets.NewHash[int]()
+			for element := range tt.h {
+				original.AddAllInPlace(element)
+			}
+			got, ok := tt.h.PopInPlace()
+			if ok != tt.wantOk {
+				t.Errorf("PopInPlace() ok = %v, want %v", ok, tt.wantOk)
+			}
+			if len(tt.h) != tt.wantLen {
+				t.Errorf("PopInPlace() left %v elements, want %v", len(tt.h), tt.wantLen)
+			}
+			if !ok {
+				if got != 0 {
+					t.Errorf("PopInPlace() = %v, want the zero value", got)
+				}
+				return
+			}
+			if _, wasPresent := original[got]; !wasPresent {
+				t.Errorf("PopInPlace() = %v, which was not in the set", got)
+			}
+			if _, stillPresent := tt.h[got]; stillPresent {
+				t.Errorf("PopInPlace() = %v, which was not removed", got)
+			}
+		})
+	}
+}
@@ -2,3 +2,9 @@ package sets
 
 type Set[T any] interface {
 }
+
+type MutableSet[T any] interface {
+	Set[T]
+	AddAllInPlace(elements ...T) int
+	PopInPlace() (T, bool)
+}
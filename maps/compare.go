@@ -0,0 +1,26 @@
+package maps
+
+// Diff compares the from map against the to map, returning the entries of to whose keys are missing from from as
+// added, the entries of from whose keys are missing from to as removed, and, for each key present in both with
+// differing values, the pair of [from, to] values as changed.  Nil input maps behave as empty maps.  Each of the
+// returned maps is non-nil, and empty if there are no differences of that kind.
+func Diff[K, V comparable](from, to map[K]V) (added map[K]V, removed map[K]V, changed map[K][2]V) {
+	added = map[K]V{}
+	removed = map[K]V{}
+	changed = map[K][2]V{}
+	for key, fromValue := range from {
+		toValue, ok := to[key]
+		switch {
+		case !ok:
+			removed[key] = fromValue
+		case fromValue != toValue:
+			changed[key] = [2]V{fromValue, toValue}
+		}
+	}
+	for key, toValue := range to {
+		if _, ok := from[key]; !ok {
+			added[key] = toValue
+		}
+	}
+	return added, removed, changed
+}
@@ -0,0 +1,105 @@
+package maps_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"reflect"
+	"testing"
+)
+
+func ExampleDiff() {
+	deployed := map[string]string{
+		"replicas": "2",
+		"image":    "app:1.0",
+		"debug":    "true",
+	}
+	desired := map[string]string{
+		"replicas": "3",
+		"image":    "app:1.0",
+		"region":   "eu",
+	}
+	added, removed, changed := maps.Diff(deployed, desired)
+
+	fmt.Printf("added: %v, removed: %v, changed: %v", added, removed, changed)
+	// Output: added: map[region:eu], removed: map[debug:true], changed: map[replicas:[2 3]]
+}
+
+func TestDiff(t *testing.T) {
+	type args[K comparable, V comparable] struct {
+		from map[K]V
+		to   map[K]V
+	}
+	type testCase[K comparable, V comparable] struct {
+		name        string
+		args        args[K, V]
+		wantAdded   map[K]V
+		wantRemoved map[K]V
+		wantChanged map[K][2]V
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "reports added, removed and changed keys",
+			args: args[string, int]{
+				from: map[string]int{"a": 1, "b": 2, "c": 3},
+				to:   map[string]int{"b": 2, "c": 30, "d": 4},
+			},
+			wantAdded:   map[string]int{"d": 4},
+			wantRemoved: map[string]int{"a": 1},
+			wantChanged: map[string][2]int{"c": {3, 30}},
+		},
+		{
+			name: "identical maps have no differences",
+			args: args[string, int]{
+				from: map[string]int{"a": 1},
+				to:   map[string]int{"a": 1},
+			},
+			wantAdded:   map[string]int{},
+			wantRemoved: map[string]int{},
+			wantChanged: map[string][2]int{},
+		},
+		{
+			name: "nil from map reports every entry as added",
+			args: args[string, int]{
+				from: nil,
+				to:   map[string]int{"a": 1},
+			},
+			wantAdded:   map[string]int{"a": 1},
+			wantRemoved: map[string]int{},
+			wantChanged: map[string][2]int{},
+		},
+		{
+			name: "nil to map reports every entry as removed",
+			args: args[string, int]{
+				from: map[string]int{"a": 1},
+				to:   nil,
+			},
+			wantAdded:   map[string]int{},
+			wantRemoved: map[string]int{"a": 1},
+			wantChanged: map[string][2]int{},
+		},
+		{
+			name: "zero values are compared rather than treated as missing",
+			args: args[string, int]{
+				from: map[string]int{"a": 0},
+				to:   map[string]int{"b": 0},
+			},
+			wantAdded:   map[string]int{"b": 0},
+			wantRemoved: map[string]int{"a": 0},
+			wantChanged: map[string][2]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAdded, gotRemoved, gotChanged := maps.Diff(tt.args.from, tt.args.to)
+			if !reflect.DeepEqual(gotAdded, tt.wantAdded) {
+				t.Errorf("Diff() gotAdded = %v, want %v", gotAdded, tt.wantAdded)
+			}
+			if !reflect.DeepEqual(gotRemoved, tt.wantRemoved) {
+				t.Errorf("Diff() gotRemoved = %v, want %v", gotRemoved, tt.wantRemoved)
+			}
+			if !reflect.DeepEqual(gotChanged, tt.wantChanged) {
+				t.Errorf("Diff() gotChanged = %v, want %v", gotChanged, tt.wantChanged)
+			}
+		})
+	}
+}
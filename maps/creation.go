@@ -8,3 +8,25 @@ func FromKeys[K comparable, V any](keys []K, defaultVal V) map[K]V {
 	}
 	return result
 }
+
+// FromKeysFunc constructs a new map with each of the input keys, setting the value of each to the result of calling the
+// value function with that key.  If a key is given more than once, the function is called again and the last result is
+// kept.  Nil or empty input creates an empty map.
+func FromKeysFunc[K comparable, V any](keys []K, valFn func(K) V) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		result[k] = valFn(k)
+	}
+	return result
+}
+
+// FromSlice constructs a new map with an entry for each element of the input slice, using the key function to produce
+// the key and the value function to produce the value of each entry.  If several elements produce the same key, the
+// value of the last of them is kept.  Nil or empty input creates an empty map.
+func FromSlice[T any, K comparable, V any](s []T, keyFn func(T) K, valFn func(T) V) map[K]V {
+	result := make(map[K]V, len(s))
+	for _, element := range s {
+		result[keyFn(element)] = valFn(element)
+	}
+	return result
+}
@@ -68,3 +68,128 @@ func TestFromKeys(t *testing.T) {
 		})
 	}
 }
+
+func ExampleFromKeysFunc() {
+	words := []string{"go", "maps", "generics"}
+	out := maps.FromKeysFunc(words, func(word string) int {
+		return len(word)
+	})
+
+	fmt.Printf("result: %v", out)
+	// Output: result: map[generics:8 go:2 maps:4]
+}
+
+func TestFromKeysFunc(t *testing.T) {
+	double := func(key int) int {
+		return key * 2
+	}
+	type args[K comparable, V any] struct {
+		keys  []K
+		valFn func(K) V
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want map[K]V
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "computes a value for each key",
+			args: args[int, int]{
+				keys:  []int{1, 2, 3, 2},
+				valFn: double,
+			},
+			want: map[int]int{1: 2, 2: 4, 3: 6},
+		},
+		{
+			name: "nil input creates empty output",
+			args: args[int, int]{
+				keys:  nil,
+				valFn: double,
+			},
+			want: map[int]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.FromKeysFunc(tt.args.keys, tt.args.valFn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromKeysFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFromSlice() {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{ID: 1, Name: "Ada"}, {ID: 2, Name: "Grace"}}
+
+	members := maps.FromSlice(users, func(u user) int {
+		return u.ID
+	}, func(u user) struct{} {
+		return struct{}{}
+	})
+
+	_, ok := members[2]
+	fmt.Printf("members: %v, has 2: %v", len(members), ok)
+	// Output: members: 2, has 2: true
+}
+
+func TestFromSlice(t *testing.T) {
+	firstLetter := func(word string) byte {
+		return word[0]
+	}
+	length := func(word string) int {
+		return len(word)
+	}
+	type args[T any, K comparable, V any] struct {
+		s     []T
+		keyFn func(T) K
+		valFn func(T) V
+	}
+	type testCase[T any, K comparable, V any] struct {
+		name string
+		args args[T, K, V]
+		want map[K]V
+	}
+	tests := []testCase[string, byte, int]{
+		{
+			name: "creates an entry for each element",
+			args: args[string, byte, int]{
+				s:     []string{"apple", "kiwi"},
+				keyFn: firstLetter,
+				valFn: length,
+			},
+			want: map[byte]int{'a': 5, 'k': 4},
+		},
+		{
+			name: "last element wins a duplicate key",
+			args: args[string, byte, int]{
+				s:     []string{"apple", "avocado"},
+				keyFn: firstLetter,
+				valFn: length,
+			},
+			want: map[byte]int{'a': 7},
+		},
+		{
+			name: "nil input creates empty output",
+			args: args[string, byte, int]{
+				s:     nil,
+				keyFn: firstLetter,
+				valFn: length,
+			},
+			want: map[byte]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.FromSlice(tt.args.s, tt.args.keyFn, tt.args.valFn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FromSlice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
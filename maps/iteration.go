@@ -0,0 +1,24 @@
+package maps
+
+import (
+	"github.com/pickeringtech/go-collections/constraints"
+	"sort"
+)
+
+// WalkSorted calls the visit function with each key and value of the input map, in ascending order of key, stopping as
+// soon as the visit function returns false.  The keys are gathered and sorted before the first visit, so this takes
+// O(n log n) time however early the walk stops.
+func WalkSorted[K constraints.Ordered, V any](m map[K]V, visit func(key K, value V) bool) {
+	keys := make([]K, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys {
+		if !visit(key, m[key]) {
+			return
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package maps_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"reflect"
+	"testing"
+)
+
+func ExampleWalkSorted() {
+	config := map[string]string{"port": "8080", "host": "localhost", "debug": "false", "timeout": "30s"}
+
+	rendered := 0
+	maps.WalkSorted(config, func(key string, value string) bool {
+		fmt.Printf("%v=%v\n", key, value)
+		rendered++
+		return rendered < 3
+	})
+	// Output:
+	// debug=false
+	// host=localhost
+	// port=8080
+}
+
+func TestWalkSorted(t *testing.T) {
+	type testCase[K comparable, V any] struct {
+		name      string
+		m         map[K]V
+		stopAfter int
+		wantKeys  []K
+	}
+	tests := []testCase[int, string]{
+		{
+			name:      "visits every entry in key order",
+			m:         map[int]string{3: "c", 1: "a", 2: "b"},
+			stopAfter: 10,
+			wantKeys:  []int{1, 2, 3},
+		},
+		{
+			name:      "stops once visit returns false",
+			m:         map[int]string{3: "c", 1: "a", 2: "b", 4: "d"},
+			stopAfter: 2,
+			wantKeys:  []int{1, 2},
+		},
+		{
+			name:      "nil map visits nothing",
+			m:         nil,
+			stopAfter: 10,
+			wantKeys:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotKeys []int
+			maps.WalkSorted(tt.m, func(key int, value string) bool {
+				if tt.m[key] != value {
+					t.Errorf("WalkSorted() visited %v with %v, want %v", key, value, tt.m[key])
+				}
+				gotKeys = append(gotKeys, key)
+				return len(gotKeys) < tt.stopAfter
+			})
+			if !reflect.DeepEqual(gotKeys, tt.wantKeys) {
+				t.Errorf("WalkSorted() visited %v, want %v", gotKeys, tt.wantKeys)
+			}
+		})
+	}
+}
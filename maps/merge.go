@@ -0,0 +1,132 @@
+package maps
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pickeringtech/go-collections/constraints"
+	"reflect"
+)
+
+// MergeStrategy determines how MergeWithStrategy resolves a key which is present in more than one of the maps being
+// merged.
+type MergeStrategy int
+
+const (
+	// KeepFirst keeps the value from the first map which holds the key.
+	KeepFirst MergeStrategy = iota
+	// KeepLast keeps the value from the last map which holds the key, as Update does.
+	KeepLast
+	// Sum adds together the values from every map which holds the key.  It may only be used with integer and float
+	// values, including named types based on them, which is checked when the merge runs - use MergeSum to have the
+	// values checked at compile time instead.
+	Sum
+	// ErrorOnConflict stops the merge with a MergeConflictError at the first key found in more than one map.
+	ErrorOnConflict
+)
+
+// ErrSumNotNumeric is returned by MergeWithStrategy when the Sum strategy is used with values which are not numeric.
+var ErrSumNotNumeric = errors.New("maps: the Sum merge strategy requires numeric values")
+
+// ErrUnknownMergeStrategy is returned by MergeWithStrategy when given a MergeStrategy which is not one of the defined
+// constants.
+var ErrUnknownMergeStrategy = errors.New("maps: unknown merge strategy")
+
+// MergeConflictError is returned by MergeWithStrategy, when using the ErrorOnConflict strategy, to report the key which
+// was found in more than one map.
+type MergeConflictError[K comparable] struct {
+	// Key is the key which was found in more than one map.
+	Key K
+}
+
+// Error describes the key which conflicted.
+func (e MergeConflictError[K]) Error() string {
+	return fmt.Sprintf("maps: conflicting key %v", e.Key)
+}
+
+// MergeWithStrategy creates a new map holding every entry of the given maps, using the strategy to resolve keys which
+// are present in more than one of them.  The given maps are not modified.  If no maps are given, an empty map is
+// returned.  On error, the returned map is nil.
+func MergeWithStrategy[K comparable, V any](strategy MergeStrategy, ms ...map[K]V) (map[K]V, error) {
+	var combine func(existing, value V) V
+	switch strategy {
+	case KeepFirst:
+		combine = func(existing, _ V) V { return existing }
+	case KeepLast:
+		combine = func(_, value V) V { return value }
+	case Sum:
+		var zero V
+		if !isNumericKind(reflect.TypeOf(zero)) {
+			return nil, ErrSumNotNumeric
+		}
+		combine = addNumeric[V]
+	case ErrorOnConflict:
+	default:
+		return nil, ErrUnknownMergeStrategy
+	}
+	return merge(combine, ms...)
+}
+
+// MergeSum creates a new map holding every entry of the given maps, adding together the values of keys which are
+// present in more than one of them.  It is the Sum strategy of MergeWithStrategy, with the values required to be
+// numeric at compile time rather than checked when the merge runs.  The given maps are not modified.  If no maps are
+// given, an empty map is returned.
+func MergeSum[K comparable, V constraints.Numeric](ms ...map[K]V) map[K]V {
+	result, _ := merge(func(existing, value V) V {
+		return existing + value
+	}, ms...)
+	return result
+}
+
+// merge creates a new map holding every entry of the given maps, using the combine function to resolve keys which are
+// present in more than one of them.  A nil combine function stops the merge with a MergeConflictError instead.
+func merge[K comparable, V any](combine func(existing, value V) V, ms ...map[K]V) (map[K]V, error) {
+	size := 0
+	for _, m := range ms {
+		size += len(m)
+	}
+	result := make(map[K]V, size)
+	for _, m := range ms {
+		for key, value := range m {
+			existing, ok := result[key]
+			if !ok {
+				result[key] = value
+				continue
+			}
+			if combine == nil {
+				return nil, MergeConflictError[K]{Key: key}
+			}
+			result[key] = combine(existing, value)
+		}
+	}
+	return result, nil
+}
+
+// isNumericKind determines whether the given type is an integer or float type.
+func isNumericKind(t reflect.Type) bool {
+	if t == nil {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+// addNumeric adds together two values of an integer or float type, which must already have been checked with
+// isNumericKind.
+func addNumeric[V any](a, b V) V {
+	av, bv := reflect.ValueOf(a), reflect.ValueOf(b)
+	sum := reflect.New(av.Type()).Elem()
+	switch av.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		sum.SetInt(av.Int() + bv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		sum.SetUint(av.Uint() + bv.Uint())
+	default:
+		sum.SetFloat(av.Float() + bv.Float())
+	}
+	return sum.Interface().(V)
+}
@@ -0,0 +1,183 @@
+package maps_test
+
+import (
+	"errors"
+	"fmt"
+	"github.com/pickeringtech/go-collections/constraints"
+	"github.com/pickeringtech/go-collections/maps"
+	"reflect"
+	"testing"
+)
+
+func ExampleMergeWithStrategy() {
+	shardA := map[string]int{"hits": 10, "misses": 2}
+	shardB := map[string]int{"hits": 5, "errors": 1}
+
+	totals, err := maps.MergeWithStrategy(maps.Sum, shardA, shardB)
+
+	fmt.Printf("totals: %v, err: %v", totals, err)
+	// Output: totals: map[errors:1 hits:15 misses:2], err: <nil>
+}
+
+func TestMergeWithStrategy(t *testing.T) {
+	type args[K comparable, V any] struct {
+		strategy maps.MergeStrategy
+		ms       []map[K]V
+	}
+	type testCase[K comparable, V any] struct {
+		name    string
+		args    args[K, V]
+		want    map[K]V
+		wantErr error
+	}
+	first := map[string]int{"a": 1, "b": 2}
+	second := map[string]int{"b": 20, "c": 30}
+	third := map[string]int{"b": 200}
+	tests := []testCase[string, int]{
+		{
+			name: "keep first keeps the earliest value",
+			args: args[string, int]{
+				strategy: maps.KeepFirst,
+				ms:       []map[string]int{first, second, third},
+			},
+			want: map[string]int{"a": 1, "b": 2, "c": 30},
+		},
+		{
+			name: "keep last keeps the latest value",
+			args: args[string, int]{
+				strategy: maps.KeepLast,
+				ms:       []map[string]int{first, second, third},
+			},
+			want: map[string]int{"a": 1, "b": 200, "c": 30},
+		},
+		{
+			name: "sum adds every value",
+			args: args[string, int]{
+				strategy: maps.Sum,
+				ms:       []map[string]int{first, second, third},
+			},
+			want: map[string]int{"a": 1, "b": 222, "c": 30},
+		},
+		{
+			name: "error on conflict reports the conflicting key",
+			args: args[string, int]{
+				strategy: maps.ErrorOnConflict,
+				ms:       []map[string]int{first, second},
+			},
+			want:    nil,
+			wantErr: maps.MergeConflictError[string]{Key: "b"},
+		},
+		{
+			name: "error on conflict without conflicts merges",
+			args: args[string, int]{
+				strategy: maps.ErrorOnConflict,
+				ms:       []map[string]int{first, {"z": 26}},
+			},
+			want: map[string]int{"a": 1, "b": 2, "z": 26},
+		},
+		{
+			name: "unknown strategy provides an error",
+			args: args[string, int]{
+				strategy: maps.MergeStrategy(42),
+				ms:       []map[string]int{first},
+			},
+			want:    nil,
+			wantErr: maps.ErrUnknownMergeStrategy,
+		},
+		{
+			name: "no maps provides an empty map",
+			args: args[string, int]{
+				strategy: maps.KeepLast,
+				ms:       nil,
+			},
+			want: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := maps.MergeWithStrategy(tt.args.strategy, tt.args.ms...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MergeWithStrategy() error = %v, want %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeWithStrategy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !reflect.DeepEqual(first, map[string]int{"a": 1, "b": 2}) {
+		t.Errorf("MergeWithStrategy() modified its input to %v", first)
+	}
+}
+
+func TestMergeWithStrategy_Sum(t *testing.T) {
+	type count uint8
+	gotCounts, err := maps.MergeWithStrategy(maps.Sum, map[string]count{"a": 1}, map[string]count{"a": 2})
+	if err != nil || !reflect.DeepEqual(gotCounts, map[string]count{"a": 3}) {
+		t.Errorf("MergeWithStrategy() = %v, %v, want map[a:3], <nil>", gotCounts, err)
+	}
+
+	gotFloats, err := maps.MergeWithStrategy(maps.Sum, map[string]float64{"a": 0.5}, map[string]float64{"a": 0.25})
+	if err != nil || !reflect.DeepEqual(gotFloats, map[string]float64{"a": 0.75}) {
+		t.Errorf("MergeWithStrategy() = %v, %v, want map[a:0.75], <nil>", gotFloats, err)
+	}
+
+	gotStrings, err := maps.MergeWithStrategy(maps.Sum, map[string]string{"a": "x"})
+	if !errors.Is(err, maps.ErrSumNotNumeric) || gotStrings != nil {
+		t.Errorf("MergeWithStrategy() = %v, %v, want nil, %v", gotStrings, err, maps.ErrSumNotNumeric)
+	}
+}
+
+func ExampleMergeSum() {
+	shardA := map[string]int{"hits": 10, "misses": 2}
+	shardB := map[string]int{"hits": 5, "errors": 1}
+
+	totals := maps.MergeSum(shardA, shardB)
+
+	fmt.Printf("totals: %v", totals)
+	// Output: totals: map[errors:1 hits:15 misses:2]
+}
+
+func TestMergeSum(t *testing.T) {
+	type args[K comparable, V constraints.Numeric] struct {
+		ms []map[K]V
+	}
+	type testCase[K comparable, V constraints.Numeric] struct {
+		name string
+		args args[K, V]
+		want map[K]V
+	}
+	first := map[string]float64{"a": 0.5, "b": 1}
+	tests := []testCase[string, float64]{
+		{
+			name: "adds the values of shared keys",
+			args: args[string, float64]{
+				ms: []map[string]float64{first, {"a": 0.25, "c": 3}, {"a": 1}},
+			},
+			want: map[string]float64{"a": 1.75, "b": 1, "c": 3},
+		},
+		{
+			name: "single map is copied",
+			args: args[string, float64]{
+				ms: []map[string]float64{first},
+			},
+			want: map[string]float64{"a": 0.5, "b": 1},
+		},
+		{
+			name: "no maps provides an empty map",
+			args: args[string, float64]{
+				ms: nil,
+			},
+			want: map[string]float64{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maps.MergeSum(tt.args.ms...); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSum() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	if !reflect.DeepEqual(first, map[string]float64{"a": 0.5, "b": 1}) {
+		t.Errorf("MergeSum() modified its input to %v", first)
+	}
+}
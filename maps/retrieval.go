@@ -1,10 +1,45 @@
 package maps
 
-// Clear removes every key-value pair from the input map, modifying the input map.
-func Clear[K comparable, V any](input map[K]V) {
+import (
+	"container/heap"
+	"github.com/pickeringtech/go-collections/constraints"
+)
+
+// FindFunc is a function which can be used to test a key-value pair in a map.  It receives the key and value and returns
+// a boolean value indicating whether the pair is a match.
+type FindFunc[K comparable, V any] func(key K, value V) bool
+
+// AllMatch tests each key-value pair of the input with the provided function.  If every pair results in a truthy
+// boolean value, true is returned.  Testing stops at the first pair which results in a falsy value, in which case false
+// is returned.  An empty or nil input map has no pairs which fail the test, so results in true.
+func AllMatch[K comparable, V any](input map[K]V, fn FindFunc[K, V]) bool {
+	for key, value := range input {
+		if !fn(key, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyMatch tests each key-value pair of the input with the provided function.  If any pair results in a truthy boolean
+// value, testing stops and true is returned.  Otherwise, including for an empty or nil input map, false is returned.
+func AnyMatch[K comparable, V any](input map[K]V, fn FindFunc[K, V]) bool {
+	for key, value := range input {
+		if fn(key, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Clear removes every key-value pair from the input map, modifying the input map, and returns how many pairs were
+// removed.  The map keeps its allocated memory, so can be reused.  A nil input map is left untouched.
+func Clear[K comparable, V any](input map[K]V) int {
+	removed := len(input)
 	for key := range input {
 		delete(input, key)
 	}
+	return removed
 }
 
 // ContainsValue searches through the input map for the given value. If the value is found, a truthy bool is returned.
@@ -77,6 +112,24 @@ func Items[K comparable, V any](input map[K]V) []Entry[K, V] {
 	return results
 }
 
+// Keep removes every key-value pair from the input map except those with the given keys, modifying the input map, and
+// returns how many pairs were removed.  A nil input map is left untouched.
+func Keep[K comparable, V any](input map[K]V, keys ...K) int {
+	toKeep := make(map[K]struct{}, len(keys))
+	for _, key := range keys {
+		toKeep[key] = struct{}{}
+	}
+	removed := 0
+	for key := range input {
+		if _, ok := toKeep[key]; ok {
+			continue
+		}
+		delete(input, key)
+		removed++
+	}
+	return removed
+}
+
 // Keys provides a slice of all the keys of the input map.
 func Keys[K comparable, V any](input map[K]V) []K {
 	var results []K
@@ -86,6 +139,61 @@ func Keys[K comparable, V any](input map[K]V) []K {
 	return results
 }
 
+// KeysInto appends all the keys of the input map to the given slice, reusing its capacity, and returns the grown slice.
+// The given slice is reset to a length of zero first, so any elements it held are overwritten.
+func KeysInto[K comparable, V any](input map[K]V, dst []K) []K {
+	dst = dst[:0]
+	for key := range input {
+		dst = append(dst, key)
+	}
+	return dst
+}
+
+// TopNByValue provides the n entries of the input map with the largest values, in descending order of value.  Only n
+// entries are held while searching, so the whole map is never sorted.  The order of entries with equal values is not
+// defined.  If n is zero or less, the output will be nil.  If n is at least the length of the input, every entry is
+// returned.
+func TopNByValue[K comparable, V constraints.Ordered](input map[K]V, n int) []Entry[K, V] {
+	if n <= 0 || len(input) == 0 {
+		return nil
+	}
+	h := &entryMinHeap[K, V]{}
+	for key, val := range input {
+		entry := Entry[K, V]{Key: key, Value: val}
+		if h.Len() < n {
+			heap.Push(h, entry)
+			continue
+		}
+		if val > (*h)[0].Value {
+			(*h)[0] = entry
+			heap.Fix(h, 0)
+		}
+	}
+	results := make([]Entry[K, V], h.Len())
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i] = heap.Pop(h).(Entry[K, V])
+	}
+	return results
+}
+
+// entryMinHeap is a heap.Interface of entries, with the entry having the smallest value at the root.
+type entryMinHeap[K comparable, V constraints.Ordered] []Entry[K, V]
+
+func (h entryMinHeap[K, V]) Len() int           { return len(h) }
+func (h entryMinHeap[K, V]) Less(i, j int) bool { return h[i].Value < h[j].Value }
+func (h entryMinHeap[K, V]) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *entryMinHeap[K, V]) Push(x any) {
+	*h = append(*h, x.(Entry[K, V]))
+}
+
+func (h *entryMinHeap[K, V]) Pop() any {
+	old := *h
+	last := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return last
+}
+
 // Values returns a slice of all the values of the input map.
 func Values[K comparable, V any](input map[K]V) []V {
 	var results []V
@@ -94,3 +202,13 @@ func Values[K comparable, V any](input map[K]V) []V {
 	}
 	return results
 }
+
+// ValuesInto appends all the values of the input map to the given slice, reusing its capacity, and returns the grown
+// slice.  The given slice is reset to a length of zero first, so any elements it held are overwritten.
+func ValuesInto[K comparable, V any](input map[K]V, dst []V) []V {
+	dst = dst[:0]
+	for _, val := range input {
+		dst = append(dst, val)
+	}
+	return dst
+}
@@ -2,21 +2,150 @@ package maps_test
 
 import (
 	"fmt"
+	"github.com/pickeringtech/go-collections/constraints"
 	"github.com/pickeringtech/go-collections/maps"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
 	"testing"
 )
 
+func ExampleAllMatch() {
+	limits := map[string]int{
+		"cpu":    4,
+		"memory": 512,
+	}
+	valid := maps.AllMatch(limits, func(key string, value int) bool {
+		return value > 0
+	})
+	fmt.Printf("valid: %v", valid)
+	// Output: valid: true
+}
+
+func TestAllMatch(t *testing.T) {
+	positive := func(key string, value int) bool {
+		return value > 0
+	}
+	type args[K comparable, V any] struct {
+		input map[K]V
+		fn    maps.FindFunc[K, V]
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want bool
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "every pair matching results in true",
+			args: args[string, int]{
+				input: map[string]int{"a": 1, "b": 2},
+				fn:    positive,
+			},
+			want: true,
+		},
+		{
+			name: "one pair not matching results in false",
+			args: args[string, int]{
+				input: map[string]int{"a": 1, "b": -2},
+				fn:    positive,
+			},
+			want: false,
+		},
+		{
+			name: "empty input results in true",
+			args: args[string, int]{
+				input: map[string]int{},
+				fn:    positive,
+			},
+			want: true,
+		},
+		{
+			name: "nil input results in true",
+			args: args[string, int]{
+				input: nil,
+				fn:    positive,
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maps.AllMatch(tt.args.input, tt.args.fn); got != tt.want {
+				t.Errorf("AllMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleAnyMatch() {
+	flags := map[string]bool{
+		"darkMode": false,
+		"beta":     true,
+	}
+	enabled := maps.AnyMatch(flags, func(key string, value bool) bool {
+		return value
+	})
+	fmt.Printf("any enabled: %v", enabled)
+	// Output: any enabled: true
+}
+
+func TestAnyMatch(t *testing.T) {
+	negative := func(key string, value int) bool {
+		return value < 0
+	}
+	type args[K comparable, V any] struct {
+		input map[K]V
+		fn    maps.FindFunc[K, V]
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want bool
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "one pair matching results in true",
+			args: args[string, int]{
+				input: map[string]int{"a": 1, "b": -2},
+				fn:    negative,
+			},
+			want: true,
+		},
+		{
+			name: "no pairs matching results in false",
+			args: args[string, int]{
+				input: map[string]int{"a": 1, "b": 2},
+				fn:    negative,
+			},
+			want: false,
+		},
+		{
+			name: "nil input results in false",
+			args: args[string, int]{
+				input: nil,
+				fn:    negative,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maps.AnyMatch(tt.args.input, tt.args.fn); got != tt.want {
+				t.Errorf("AnyMatch() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleClear() {
 	input := map[int]string{
 		1:  "one",
 		-1: "negative one",
 		10: "ten",
 	}
-	maps.Clear(input)
-	fmt.Printf("%v", input)
-	// Output: map[]
+	removed := maps.Clear(input)
+	fmt.Printf("%v, removed: %v", input, removed)
+	// Output: map[], removed: 3
 }
 
 func TestClear(t *testing.T) {
@@ -26,6 +155,7 @@ func TestClear(t *testing.T) {
 	type testCase[K comparable, V any] struct {
 		name string
 		args args[K, V]
+		want int
 	}
 	tests := []testCase[int, string]{
 		{
@@ -37,14 +167,25 @@ func TestClear(t *testing.T) {
 					10: "ten",
 				},
 			},
+			want: 3,
+		},
+		{
+			name: "nil input map removes nothing",
+			args: args[int, string]{
+				input: nil,
+			},
+			want: 0,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			maps.Clear(tt.args.input)
+			got := maps.Clear(tt.args.input)
 			if len(tt.args.input) > 0 {
 				t.Errorf("clear did not remove all entries in the input map: %v", tt.args.input)
 			}
+			if got != tt.want {
+				t.Errorf("Clear() = %v, want %v", got, tt.want)
+			}
 		})
 	}
 }
@@ -415,6 +556,79 @@ func TestItems(t *testing.T) {
 	}
 }
 
+func ExampleKeep() {
+	input := map[string]int{
+		"a": 1,
+		"b": 2,
+		"c": 3,
+	}
+	removed := maps.Keep(input, "a", "c", "z")
+	fmt.Printf("%v, removed: %v", input, removed)
+	// Output: map[a:1 c:3], removed: 1
+}
+
+func TestKeep(t *testing.T) {
+	type args[K comparable, V any] struct {
+		input map[K]V
+		keys  []K
+	}
+	type testCase[K comparable, V any] struct {
+		name      string
+		args      args[K, V]
+		want      int
+		wantInput map[K]V
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "removes entries without the given keys",
+			args: args[int, string]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+					10: "ten",
+				},
+				keys: []int{1, 10, 100},
+			},
+			want: 1,
+			wantInput: map[int]string{
+				1:  "one",
+				10: "ten",
+			},
+		},
+		{
+			name: "no keys removes every entry",
+			args: args[int, string]{
+				input: map[int]string{
+					1: "one",
+				},
+				keys: nil,
+			},
+			want:      1,
+			wantInput: map[int]string{},
+		},
+		{
+			name: "nil input map removes nothing",
+			args: args[int, string]{
+				input: nil,
+				keys:  []int{1},
+			},
+			want:      0,
+			wantInput: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.Keep(tt.args.input, tt.args.keys...)
+			if got != tt.want {
+				t.Errorf("Keep() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.args.input, tt.wantInput) {
+				t.Errorf("Keep() resulted in %v, want %v", tt.args.input, tt.wantInput)
+			}
+		})
+	}
+}
+
 func ExampleKeys() {
 	input := map[int]string{
 		1: "one",
@@ -465,6 +679,152 @@ func TestKeys(t *testing.T) {
 	}
 }
 
+func ExampleKeysInto() {
+	buffer := make([]int, 0, 8)
+
+	for _, input := range []map[int]string{{1: "one"}, {2: "two"}} {
+		buffer = maps.KeysInto(input, buffer)
+		fmt.Printf("keys: %v, capacity: %v\n", buffer, cap(buffer))
+	}
+	// Output:
+	// keys: [1], capacity: 8
+	// keys: [2], capacity: 8
+}
+
+func TestKeysInto(t *testing.T) {
+	type args[K comparable, V any] struct {
+		input map[K]V
+		dst   []K
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want []K
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "overwrites the elements of the slice with the keys",
+			args: args[int, string]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+				},
+				dst: []int{7, 8, 9},
+			},
+			want: []int{-1, 1},
+		},
+		{
+			name: "nil slice is grown as needed",
+			args: args[int, string]{
+				input: map[int]string{1: "one"},
+				dst:   nil,
+			},
+			want: []int{1},
+		},
+		{
+			name: "empty input resets the slice",
+			args: args[int, string]{
+				input: map[int]string{},
+				dst:   []int{7, 8, 9},
+			},
+			want: []int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.KeysInto(tt.args.input, tt.args.dst)
+			slices.SortOrderedAscInPlace(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KeysInto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleTopNByValue() {
+	requests := map[string]int{
+		"/login":  120,
+		"/health": 900,
+		"/search": 450,
+		"/logout": 30,
+	}
+
+	top := maps.TopNByValue(requests, 2)
+	fmt.Printf("top: %v", top)
+	// Output: top: [{/health 900} {/search 450}]
+}
+
+func TestTopNByValue(t *testing.T) {
+	type args[K comparable, V constraints.Ordered] struct {
+		input map[K]V
+		n     int
+	}
+	type testCase[K comparable, V constraints.Ordered] struct {
+		name string
+		args args[K, V]
+		want []maps.Entry[K, V]
+	}
+	input := map[string]int{
+		"a": 5,
+		"b": 1,
+		"c": 9,
+		"d": 3,
+		"e": 7,
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "provides the entries with the largest values in descending order",
+			args: args[string, int]{
+				input: input,
+				n:     3,
+			},
+			want: []maps.Entry[string, int]{
+				{Key: "c", Value: 9},
+				{Key: "e", Value: 7},
+				{Key: "a", Value: 5},
+			},
+		},
+		{
+			name: "n beyond the length provides every entry in descending order",
+			args: args[string, int]{
+				input: input,
+				n:     10,
+			},
+			want: []maps.Entry[string, int]{
+				{Key: "c", Value: 9},
+				{Key: "e", Value: 7},
+				{Key: "a", Value: 5},
+				{Key: "d", Value: 3},
+				{Key: "b", Value: 1},
+			},
+		},
+		{
+			name: "zero n provides nil output",
+			args: args[string, int]{
+				input: input,
+				n:     0,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input provides nil output",
+			args: args[string, int]{
+				input: nil,
+				n:     3,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.TopNByValue(tt.args.input, tt.args.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TopNByValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleValues() {
 	input := map[int]string{
 		1: "one",
@@ -514,3 +874,65 @@ func TestValues(t *testing.T) {
 		})
 	}
 }
+
+func ExampleValuesInto() {
+	buffer := make([]string, 0, 8)
+
+	for _, input := range []map[int]string{{1: "one"}, {2: "two"}} {
+		buffer = maps.ValuesInto(input, buffer)
+		fmt.Printf("values: %v, capacity: %v\n", buffer, cap(buffer))
+	}
+	// Output:
+	// values: [one], capacity: 8
+	// values: [two], capacity: 8
+}
+
+func TestValuesInto(t *testing.T) {
+	type args[K comparable, V any] struct {
+		input map[K]V
+		dst   []V
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want []V
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "overwrites the elements of the slice with the values",
+			args: args[int, string]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+				},
+				dst: []string{"x", "y", "z"},
+			},
+			want: []string{"negative one", "one"},
+		},
+		{
+			name: "nil slice is grown as needed",
+			args: args[int, string]{
+				input: map[int]string{1: "one"},
+				dst:   nil,
+			},
+			want: []string{"one"},
+		},
+		{
+			name: "empty input resets the slice",
+			args: args[int, string]{
+				input: map[int]string{},
+				dst:   []string{"x"},
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.ValuesInto(tt.args.input, tt.args.dst)
+			slices.SortOrderedAscInPlace(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValuesInto() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -15,3 +15,19 @@ func Filter[K comparable, V any](input map[K]V, fn FilterFunc[K, V]) map[K]V {
 	}
 	return result
 }
+
+// FilterToEntries applies the provided FilterFunc to each entry in the input map, returning a slice of the entries for
+// which the FilterFunc returns true.  The order of the entries is not defined, but unlike a map the resulting slice can
+// be sorted to give a deterministic order.  If no entries match, or the input is nil, the output will be nil.
+func FilterToEntries[K comparable, V any](input map[K]V, fn FilterFunc[K, V]) []Entry[K, V] {
+	var results []Entry[K, V]
+	for key, value := range input {
+		if fn(key, value) {
+			results = append(results, Entry[K, V]{
+				Key:   key,
+				Value: value,
+			})
+		}
+	}
+	return results
+}
@@ -3,6 +3,7 @@ package maps_test
 import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
 	"testing"
 )
@@ -74,3 +75,91 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func ExampleFilterToEntries() {
+	input := map[string]int{
+		"b": 2,
+		"a": 1,
+		"c": -3,
+	}
+	out := maps.FilterToEntries(input, func(key string, value int) bool {
+		return value > 0
+	})
+	out = slices.SortByOrderedField(out, slices.AscendingSortFunc[string], func(e maps.Entry[string, int]) string {
+		return e.Key
+	})
+
+	fmt.Printf("result: %v", out)
+	// Output: result: [{a 1} {b 2}]
+}
+
+func TestFilterToEntries(t *testing.T) {
+	type args[K comparable, V any] struct {
+		input map[K]V
+		fn    maps.FilterFunc[K, V]
+	}
+	type testCase[K comparable, V any] struct {
+		name string
+		args args[K, V]
+		want []maps.Entry[K, V]
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "provides entries with positive keys",
+			args: args[int, string]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+					0:  "zero",
+					10: "ten",
+				},
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: []maps.Entry[int, string]{
+				{
+					Key:   1,
+					Value: "one",
+				},
+				{
+					Key:   10,
+					Value: "ten",
+				},
+			},
+		},
+		{
+			name: "no matching entries provides nil output",
+			args: args[int, string]{
+				input: map[int]string{
+					-1: "negative one",
+				},
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "nil input provides nil output",
+			args: args[int, string]{
+				input: nil,
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.FilterToEntries(tt.args.input, tt.args.fn)
+			got = slices.SortByOrderedField(got, slices.AscendingSortFunc[int], func(e maps.Entry[int, string]) int {
+				return e.Key
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterToEntries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package maps
+
+// GroupFunc is a function that takes a key and value and returns the group which the entry belongs to.
+type GroupFunc[K comparable, V any, G comparable] func(key K, value V) G
+
+// GroupBy places the value of each entry in the input map into a bucket for the group returned by the provided
+// GroupFunc, building a new map of groups to the values within them.  The original keys are discarded, and as the
+// iteration order of a map is not defined, neither is the order of the values within each group.  If the input is
+// empty or nil, the output will be an empty map.
+func GroupBy[K comparable, V any, G comparable](input map[K]V, fn GroupFunc[K, V, G]) map[G][]V {
+	results := map[G][]V{}
+	for key, value := range input {
+		group := fn(key, value)
+		results[group] = append(results[group], value)
+	}
+	return results
+}
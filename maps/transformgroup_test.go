@@ -0,0 +1,87 @@
+package maps_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func ExampleGroupBy() {
+	salaries := map[string]int{
+		"eng-1":   100,
+		"eng-2":   120,
+		"sales-1": 80,
+	}
+	byDepartment := maps.GroupBy(salaries, func(employeeID string, salary int) string {
+		department, _, _ := strings.Cut(employeeID, "-")
+		return department
+	})
+
+	fmt.Printf("engineering average: %v, sales average: %v", slices.Avg(byDepartment["eng"]), slices.Avg(byDepartment["sales"]))
+	// Output: engineering average: 110, sales average: 80
+}
+
+func TestGroupBy(t *testing.T) {
+	type args[K comparable, V any, G comparable] struct {
+		input map[K]V
+		fn    maps.GroupFunc[K, V, G]
+	}
+	type testCase[K comparable, V any, G comparable] struct {
+		name string
+		args args[K, V, G]
+		want map[G][]V
+	}
+	tests := []testCase[int, string, bool]{
+		{
+			name: "groups values by the sign of their keys",
+			args: args[int, string, bool]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+					2:  "two",
+				},
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: map[bool][]string{
+				true:  {"one", "two"},
+				false: {"negative one"},
+			},
+		},
+		{
+			name: "empty input provides empty output",
+			args: args[int, string, bool]{
+				input: map[int]string{},
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: map[bool][]string{},
+		},
+		{
+			name: "nil input provides empty output",
+			args: args[int, string, bool]{
+				input: nil,
+				fn: func(key int, value string) bool {
+					return key > 0
+				},
+			},
+			want: map[bool][]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.GroupBy(tt.args.input, tt.args.fn)
+			for group, values := range got {
+				got[group] = slices.SortOrderedAsc(values)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
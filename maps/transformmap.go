@@ -14,3 +14,51 @@ func Map[K comparable, V any, OK comparable, OV any](input map[K]V, fn MapFunc[K
 	}
 	return results
 }
+
+// Transform takes each entry in the input map, transforming the key with the key function and the value with the value
+// function, building a new map to output in a single pass.  It does not modify the input map.  When the key function
+// produces the same output key for several entries, the last entry written wins - as map iteration order is random,
+// which of those values is kept is not defined.
+func Transform[K comparable, V any, OK comparable, OV any](input map[K]V, keyFn func(K) OK, valFn func(V) OV) map[OK]OV {
+	results := make(map[OK]OV, len(input))
+	for key, value := range input {
+		results[keyFn(key)] = valFn(value)
+	}
+	return results
+}
+
+// FlatMapFunc is a function that takes a key and value and returns any number of new entries.
+type FlatMapFunc[K comparable, V any, OK comparable, OV any] func(key K, value V) []Entry[OK, OV]
+
+// FlatMap takes each entry in the input map, expanding it into zero or more entries using the provided function, and
+// builds a new map from all of the resulting entries.  It does not modify the input map.  When several entries share
+// the same key, the last one written wins - across entries of the input, map iteration order is random, so which of
+// those values is kept is not defined.
+func FlatMap[K comparable, V any, OK comparable, OV any](input map[K]V, fn FlatMapFunc[K, V, OK, OV]) map[OK]OV {
+	results := map[OK]OV{}
+	for key, value := range input {
+		for _, entry := range fn(key, value) {
+			results[entry.Key] = entry.Value
+		}
+	}
+	return results
+}
+
+// InvertWith builds a new map from the input with each key and value swapped, so that the values of the input become
+// the keys of the output.  When several keys share the same value, the resolve function is called with that value,
+// the key already stored against it and the newly found key, and the key it returns is kept.  As map iteration order
+// is random, the keys are offered to the resolve function in no particular order - the result is deterministic as long
+// as the resolve function's choice does not depend on that order, such as keeping the smallest key.  It does not
+// modify the input map.  Nil or empty input creates an empty map.
+func InvertWith[K comparable, V comparable](m map[K]V, resolve func(value V, existingKey, newKey K) K) map[V]K {
+	results := make(map[V]K, len(m))
+	for key, value := range m {
+		existing, ok := results[value]
+		if !ok {
+			results[value] = key
+			continue
+		}
+		results[value] = resolve(value, existing, key)
+	}
+	return results
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/maps"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -79,3 +81,245 @@ func TestMap(t *testing.T) {
 		})
 	}
 }
+
+func ExampleTransform() {
+	input := map[int]string{
+		1: "one",
+		2: "two",
+	}
+	out := maps.Transform(input, strconv.Itoa, strings.ToUpper)
+
+	fmt.Printf("%v", out)
+	// Output: map[1:ONE 2:TWO]
+}
+
+func TestTransform(t *testing.T) {
+	type args[K comparable, V any, OK comparable, OV any] struct {
+		input map[K]V
+		keyFn func(K) OK
+		valFn func(V) OV
+	}
+	type testCase[K comparable, V any, OK comparable, OV any] struct {
+		name string
+		args args[K, V, OK, OV]
+		want map[OK]OV
+	}
+	tests := []testCase[int, string, string, int]{
+		{
+			name: "transforms keys and values independently",
+			args: args[int, string, string, int]{
+				input: map[int]string{
+					1:  "one",
+					-1: "negative one",
+				},
+				keyFn: strconv.Itoa,
+				valFn: func(value string) int {
+					return len(value)
+				},
+			},
+			want: map[string]int{
+				"1":  3,
+				"-1": 12,
+			},
+		},
+		{
+			name: "colliding keys keep a single entry",
+			args: args[int, string, string, int]{
+				input: map[int]string{
+					1: "one",
+					2: "two",
+				},
+				keyFn: func(key int) string {
+					return "same"
+				},
+				valFn: func(value string) int {
+					return len(value)
+				},
+			},
+			want: map[string]int{
+				"same": 3,
+			},
+		},
+		{
+			name: "nil input provides empty output",
+			args: args[int, string, string, int]{
+				input: nil,
+				keyFn: strconv.Itoa,
+				valFn: func(value string) int {
+					return len(value)
+				},
+			},
+			want: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.Transform(tt.args.input, tt.args.keyFn, tt.args.valFn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Transform() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFlatMap() {
+	type grant struct {
+		user string
+		role string
+	}
+	roles := map[string][]string{
+		"alice": {"admin", "dev"},
+		"bob":   {"dev"},
+	}
+	out := maps.FlatMap(roles, func(user string, roles []string) []maps.Entry[grant, bool] {
+		var entries []maps.Entry[grant, bool]
+		for _, role := range roles {
+			entries = append(entries, maps.Entry[grant, bool]{Key: grant{user, role}, Value: true})
+		}
+		return entries
+	})
+
+	fmt.Printf("%v", out)
+	// Output: map[{alice admin}:true {alice dev}:true {bob dev}:true]
+}
+
+func TestFlatMap(t *testing.T) {
+	type args[K comparable, V any, OK comparable, OV any] struct {
+		input map[K]V
+		fn    maps.FlatMapFunc[K, V, OK, OV]
+	}
+	type testCase[K comparable, V any, OK comparable, OV any] struct {
+		name string
+		args args[K, V, OK, OV]
+		want map[OK]OV
+	}
+	repeat := func(key string, value int) []maps.Entry[string, int] {
+		var entries []maps.Entry[string, int]
+		for i := 0; i < value; i++ {
+			entries = append(entries, maps.Entry[string, int]{Key: key + strconv.Itoa(i), Value: i})
+		}
+		return entries
+	}
+	tests := []testCase[string, int, string, int]{
+		{
+			name: "expands each entry into many entries",
+			args: args[string, int, string, int]{
+				input: map[string]int{"a": 2, "b": 1},
+				fn:    repeat,
+			},
+			want: map[string]int{"a0": 0, "a1": 1, "b0": 0},
+		},
+		{
+			name: "entries expanding to nothing are dropped",
+			args: args[string, int, string, int]{
+				input: map[string]int{"a": 0, "b": 1},
+				fn:    repeat,
+			},
+			want: map[string]int{"b0": 0},
+		},
+		{
+			name: "later entries of the same expansion win",
+			args: args[string, int, string, int]{
+				input: map[string]int{"a": 1},
+				fn: func(key string, value int) []maps.Entry[string, int] {
+					return []maps.Entry[string, int]{{Key: key, Value: 1}, {Key: key, Value: 2}}
+				},
+			},
+			want: map[string]int{"a": 2},
+		},
+		{
+			name: "nil input provides empty output",
+			args: args[string, int, string, int]{
+				input: nil,
+				fn:    repeat,
+			},
+			want: map[string]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := maps.FlatMap(tt.args.input, tt.args.fn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlatMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleInvertWith() {
+	teams := map[string]string{"bob": "red", "alice": "red", "carol": "blue"}
+	keepFirstName := func(team string, existingName, newName string) string {
+		if newName < existingName {
+			return newName
+		}
+		return existingName
+	}
+
+	captains := maps.InvertWith(teams, keepFirstName)
+
+	fmt.Printf("red: %v, blue: %v", captains["red"], captains["blue"])
+	// Output: red: alice, blue: carol
+}
+
+func TestInvertWith(t *testing.T) {
+	keepSmallest := func(value string, existingKey, newKey int) int {
+		if newKey < existingKey {
+			return newKey
+		}
+		return existingKey
+	}
+	type args[K comparable, V comparable] struct {
+		m       map[K]V
+		resolve func(value V, existingKey, newKey K) K
+	}
+	type testCase[K comparable, V comparable] struct {
+		name         string
+		args         args[K, V]
+		want         map[V]K
+		wantResolves int
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "swaps keys and values",
+			args: args[int, string]{
+				m:       map[int]string{1: "one", 2: "two"},
+				resolve: keepSmallest,
+			},
+			want:         map[string]int{"one": 1, "two": 2},
+			wantResolves: 0,
+		},
+		{
+			name: "resolves keys sharing a value",
+			args: args[int, string]{
+				m:       map[int]string{5: "odd", 1: "odd", 3: "odd", 2: "even"},
+				resolve: keepSmallest,
+			},
+			want:         map[string]int{"odd": 1, "even": 2},
+			wantResolves: 2,
+		},
+		{
+			name: "nil input creates empty output",
+			args: args[int, string]{
+				m:       nil,
+				resolve: keepSmallest,
+			},
+			want:         map[string]int{},
+			wantResolves: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolves := 0
+			got := maps.InvertWith(tt.args.m, func(value string, existingKey, newKey int) int {
+				resolves++
+				return tt.args.resolve(value, existingKey, newKey)
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InvertWith() = %v, want %v", got, tt.want)
+			}
+			if resolves != tt.wantResolves {
+				t.Errorf("InvertWith() resolved %v times, want %v", resolves, tt.wantResolves)
+			}
+		})
+	}
+}
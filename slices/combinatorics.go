@@ -0,0 +1,92 @@
+package slices
+
+import "github.com/pickeringtech/go-collections/maps"
+
+// Combinations provides every way of choosing k elements from the input, where the elements of each combination keep
+// the order they have within the input.  The number of combinations grows quickly with the length of the input, so
+// this is only suitable for small inputs.  If k is zero or less, or greater than the length of the input, the output
+// will be nil.
+func Combinations[T any](input []T, k int) [][]T {
+	if k <= 0 || k > len(input) {
+		return nil
+	}
+	var results [][]T
+	indexes := make([]int, k)
+	for i := range indexes {
+		indexes[i] = i
+	}
+	for {
+		combination := make([]T, k)
+		for i, idx := range indexes {
+			combination[i] = input[idx]
+		}
+		results = append(results, combination)
+
+		// Find the rightmost index which can still be advanced, then reset every index after it.
+		i := k - 1
+		for i >= 0 && indexes[i] == len(input)-k+i {
+			i--
+		}
+		if i < 0 {
+			return results
+		}
+		indexes[i]++
+		for j := i + 1; j < k; j++ {
+			indexes[j] = indexes[j-1] + 1
+		}
+	}
+}
+
+// Pairs provides every distinct pair of elements from the input, with the key of each pair being the element which
+// appears first in the input.  If the input has fewer than two elements, the output will be nil.
+func Pairs[T comparable](input []T) []maps.Entry[T, T] {
+	var results []maps.Entry[T, T]
+	for i := 0; i < len(input); i++ {
+		for j := i + 1; j < len(input); j++ {
+			results = append(results, maps.Entry[T, T]{
+				Key:   input[i],
+				Value: input[j],
+			})
+		}
+	}
+	return results
+}
+
+// Permutations provides every ordering of the elements of the input, in lexicographic order of their positions within
+// the input - the first permutation is the input itself.  An input of n elements has n! permutations, so this is only
+// suitable for small inputs.  Empty or nil input results in nil.
+func Permutations[T any](input []T) [][]T {
+	if len(input) == 0 {
+		return nil
+	}
+	var results [][]T
+	indexes := make([]int, len(input))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	for {
+		permutation := make([]T, len(input))
+		for i, idx := range indexes {
+			permutation[i] = input[idx]
+		}
+		results = append(results, permutation)
+
+		// Find the rightmost index which is smaller than its successor - if there is none, this was the last ordering.
+		i := len(indexes) - 2
+		for i >= 0 && indexes[i] > indexes[i+1] {
+			i--
+		}
+		if i < 0 {
+			return results
+		}
+		// Swap it with the smallest larger index to its right, then reverse everything after it.
+		j := len(indexes) - 1
+		for indexes[j] < indexes[i] {
+			j--
+		}
+		indexes[i], indexes[j] = indexes[j], indexes[i]
+		for left, right := i+1, len(indexes)-1; left < right; left, right = left+1, right-1 {
+			indexes[left], indexes[right] = indexes[right], indexes[left]
+		}
+	}
+}
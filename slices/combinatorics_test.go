@@ -0,0 +1,257 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleCombinations() {
+	input := []string{"a", "b", "c", "d"}
+
+	fmt.Printf("combinations: %v", slices.Combinations(input, 3))
+	// Output: combinations: [[a b c] [a b d] [a c d] [b c d]]
+}
+
+func TestCombinations(t *testing.T) {
+	type args struct {
+		input []int
+		k     int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "provides every pair in input order",
+			args: args{
+				input: []int{1, 2, 3},
+				k:     2,
+			},
+			want: [][]int{{1, 2}, {1, 3}, {2, 3}},
+		},
+		{
+			name: "k of one provides each element alone",
+			args: args{
+				input: []int{1, 2, 3},
+				k:     1,
+			},
+			want: [][]int{{1}, {2}, {3}},
+		},
+		{
+			name: "k of the input length provides the whole input",
+			args: args{
+				input: []int{1, 2, 3},
+				k:     3,
+			},
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "k greater than the input length results in nil",
+			args: args{
+				input: []int{1, 2, 3},
+				k:     4,
+			},
+			want: nil,
+		},
+		{
+			name: "zero k results in nil",
+			args: args{
+				input: []int{1, 2, 3},
+				k:     0,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input results in nil",
+			args: args{
+				input: nil,
+				k:     1,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Combinations(tt.args.input, tt.args.k)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Combinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCombinations(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+		k    int
+	}{
+		{
+			name: "10 elements choose 3",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+			k:    3,
+		},
+		{
+			name: "20 elements choose 4",
+			sli:  slices.Generate(20, slices.NumericIdentityGenerator[int]),
+			k:    4,
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Combinations(bm.sli, bm.k)
+			}
+		})
+	}
+}
+
+func ExamplePairs() {
+	teams := []string{"red", "green", "blue"}
+
+	for _, match := range slices.Pairs(teams) {
+		fmt.Printf("%v vs %v\n", match.Key, match.Value)
+	}
+	// Output:
+	// red vs green
+	// red vs blue
+	// green vs blue
+}
+
+func TestPairs(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []maps.Entry[int, int]
+	}{
+		{
+			name: "provides every distinct pair",
+			args: args{
+				input: []int{1, 2, 3},
+			},
+			want: []maps.Entry[int, int]{
+				{Key: 1, Value: 2},
+				{Key: 1, Value: 3},
+				{Key: 2, Value: 3},
+			},
+		},
+		{
+			name: "single element results in nil",
+			args: args{
+				input: []int{1},
+			},
+			want: nil,
+		},
+		{
+			name: "nil input results in nil",
+			args: args{
+				input: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Pairs(tt.args.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Pairs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExamplePermutations() {
+	tasks := []string{"a", "b", "c"}
+
+	fmt.Printf("permutations: %v", slices.Permutations(tasks))
+	// Output: permutations: [[a b c] [a c b] [b a c] [b c a] [c a b] [c b a]]
+}
+
+func TestPermutations(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "provides every ordering",
+			args: args{
+				input: []int{3, 1, 2},
+			},
+			want: [][]int{{3, 1, 2}, {3, 2, 1}, {1, 3, 2}, {1, 2, 3}, {2, 3, 1}, {2, 1, 3}},
+		},
+		{
+			name: "repeated elements are treated as distinct",
+			args: args{
+				input: []int{1, 1},
+			},
+			want: [][]int{{1, 1}, {1, 1}},
+		},
+		{
+			name: "single element provides one permutation",
+			args: args{
+				input: []int{1},
+			},
+			want: [][]int{{1}},
+		},
+		{
+			name: "nil input results in nil",
+			args: args{
+				input: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "empty input results in nil",
+			args: args{
+				input: []int{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Permutations(tt.args.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Permutations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkPermutations(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "6 elements",
+			sli:  slices.Generate(6, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "8 elements",
+			sli:  slices.Generate(8, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.Permutations(bm.sli)
+			}
+		})
+	}
+}
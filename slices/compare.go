@@ -0,0 +1,47 @@
+package slices
+
+// CommonPrefix provides a copy of the longest run of leading elements shared by both input slices.  If the inputs do not
+// share a first element, or either is empty or nil, the output will be nil.
+func CommonPrefix[T comparable](inputA, inputB []T) []T {
+	return CommonPrefixAll(inputA, inputB)
+}
+
+// CommonPrefixAll provides a copy of the longest run of leading elements shared by every one of the input slices.  A
+// single input is its own prefix.  If the inputs do not share a first element, any of them is empty or nil, or no
+// inputs are given, the output will be nil.
+func CommonPrefixAll[T comparable](inputs ...[]T) []T {
+	if len(inputs) == 0 {
+		return nil
+	}
+	prefix := inputs[0]
+	for _, input := range inputs[1:] {
+		length := 0
+		for length < len(prefix) && length < len(input) && prefix[length] == input[length] {
+			length++
+		}
+		prefix = prefix[:length]
+	}
+	if len(prefix) == 0 {
+		return nil
+	}
+	return Copy(prefix)
+}
+
+// EqualUnordered determines whether the two input slices contain the same elements, each occurring the same number of
+// times, regardless of the order they appear in.  Nil and empty slices are considered equal.
+func EqualUnordered[T comparable](inputA, inputB []T) bool {
+	if len(inputA) != len(inputB) {
+		return false
+	}
+	counts := make(map[T]int, len(inputA))
+	for _, element := range inputA {
+		counts[element]++
+	}
+	for _, element := range inputB {
+		counts[element]--
+		if counts[element] < 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,207 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleCommonPrefixAll() {
+	metrics := [][]string{
+		{"service", "api", "requests", "total"},
+		{"service", "api", "requests", "failed"},
+		{"service", "api", "latency"},
+	}
+
+	prefix := slices.CommonPrefixAll(metrics...)
+	fmt.Printf("prefix: %v", prefix)
+	// Output: prefix: [service api]
+}
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputA []int
+		inputB []int
+		want   []int
+	}{
+		{
+			name:   "provides the shared leading elements",
+			inputA: []int{1, 2, 3, 4},
+			inputB: []int{1, 2, 5},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "shorter input which is a prefix of the other is provided",
+			inputA: []int{1, 2},
+			inputB: []int{1, 2, 3},
+			want:   []int{1, 2},
+		},
+		{
+			name:   "different first elements provide nil",
+			inputA: []int{1, 2},
+			inputB: []int{2, 2},
+			want:   nil,
+		},
+		{
+			name:   "nil input provides nil",
+			inputA: nil,
+			inputB: []int{1},
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.CommonPrefix(tt.inputA, tt.inputB)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CommonPrefix() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommonPrefixAll(t *testing.T) {
+	tests := []struct {
+		name   string
+		inputs [][]string
+		want   []string
+	}{
+		{
+			name:   "provides the prefix shared by every input",
+			inputs: [][]string{{"a", "b", "c"}, {"a", "b", "d"}, {"a", "x"}},
+			want:   []string{"a"},
+		},
+		{
+			name:   "single input is its own prefix",
+			inputs: [][]string{{"a", "b"}},
+			want:   []string{"a", "b"},
+		},
+		{
+			name:   "any empty input provides nil",
+			inputs: [][]string{{"a"}, {}, {"a"}},
+			want:   nil,
+		},
+		{
+			name:   "no inputs provide nil",
+			inputs: nil,
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.CommonPrefixAll(tt.inputs...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CommonPrefixAll() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 {
+				got[0] = "changed"
+				if tt.inputs[0][0] == "changed" {
+					t.Errorf("CommonPrefixAll() shares its elements with the input")
+				}
+			}
+		})
+	}
+}
+
+func ExampleEqualUnordered() {
+	a := []string{"x", "y", "y", "z"}
+	b := []string{"y", "z", "x", "y"}
+	c := []string{"x", "y", "z", "z"}
+
+	fmt.Printf("a equals b: %v, a equals c: %v", slices.EqualUnordered(a, b), slices.EqualUnordered(a, c))
+	// Output: a equals b: true, a equals c: false
+}
+
+func TestEqualUnordered(t *testing.T) {
+	type args struct {
+		inputA []int
+		inputB []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "same elements in a different order are equal",
+			args: args{
+				inputA: []int{1, 2, 3},
+				inputB: []int{3, 1, 2},
+			},
+			want: true,
+		},
+		{
+			name: "different multiplicities are not equal",
+			args: args{
+				inputA: []int{1, 1, 2},
+				inputB: []int{1, 2, 2},
+			},
+			want: false,
+		},
+		{
+			name: "different lengths are not equal",
+			args: args{
+				inputA: []int{1, 2},
+				inputB: []int{1, 2, 2},
+			},
+			want: false,
+		},
+		{
+			name: "different elements are not equal",
+			args: args{
+				inputA: []int{1, 2},
+				inputB: []int{3, 4},
+			},
+			want: false,
+		},
+		{
+			name: "nil and empty are equal",
+			args: args{
+				inputA: nil,
+				inputB: []int{},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.EqualUnordered(tt.args.inputA, tt.args.inputB)
+			if got != tt.want {
+				t.Errorf("EqualUnordered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkEqualUnordered(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		reversed := slices.Reverse(bm.sli)
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.EqualUnordered(bm.sli, reversed)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package slices
+
+// EachFunc is a function which receives an element of a slice.
+type EachFunc[T any] func(element T)
+
+// IndexedEachFunc is a function which receives an element of a slice, along with its index.
+type IndexedEachFunc[T any] func(idx int, element T)
+
+// WindowFunc is a function which receives a window over consecutive elements of a slice.
+type WindowFunc[T any] func(window []T)
+
+// EachWindow calls the provided function with each sliding window of the given size over the input, starting with the
+// window beginning at the first element and moving along one element at a time.  No new slices are allocated: each
+// window is a view onto the input's backing array, so it must not be modified, and must be copied if it is to be kept
+// after the function returns.  If size is zero or less, or greater than the length of the input, the function is never
+// called.  Panics if the function is nil.
+func EachWindow[T any](input []T, size int, fn WindowFunc[T]) {
+	if fn == nil {
+		panic("slices.EachWindow: fn must not be nil")
+	}
+	if size <= 0 || size > len(input) {
+		return
+	}
+	for i := 0; i+size <= len(input); i++ {
+		fn(input[i : i+size : i+size])
+	}
+}
+
+// ForEachReverse calls the provided function with each element of the input, starting with the last element and
+// working back to the first.  If the input is empty or nil, the function is never called.
+// Panics if the function is nil.
+func ForEachReverse[T any](input []T, fn EachFunc[T]) {
+	if fn == nil {
+		panic("slices.ForEachReverse: fn must not be nil")
+	}
+	for i := len(input) - 1; i >= 0; i-- {
+		fn(input[i])
+	}
+}
+
+// ForEachReverseWithIndex calls the provided function with each element of the input and its index, starting with the
+// last element and working back to the first, so the indexes count down.  If the input is empty or nil, the function
+// is never called.  Panics if the function is nil.
+func ForEachReverseWithIndex[T any](input []T, fn IndexedEachFunc[T]) {
+	if fn == nil {
+		panic("slices.ForEachReverseWithIndex: fn must not be nil")
+	}
+	for i := len(input) - 1; i >= 0; i-- {
+		fn(i, input[i])
+	}
+}
@@ -0,0 +1,238 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleEachWindow() {
+	input := []int{1, 2, 3, 4, 5}
+
+	slices.EachWindow(input, 3, func(window []int) {
+		fmt.Printf("window: %v, average: %v\n", window, slices.Avg(window))
+	})
+
+	// Output:
+	// window: [1 2 3], average: 2
+	// window: [2 3 4], average: 3
+	// window: [3 4 5], average: 4
+}
+
+func TestEachWindow(t *testing.T) {
+	type args struct {
+		input []int
+		size  int
+	}
+	tests := []struct {
+		name string
+		args args
+		want [][]int
+	}{
+		{
+			name: "visits each sliding window",
+			args: args{
+				input: []int{1, 2, 3, 4},
+				size:  2,
+			},
+			want: [][]int{{1, 2}, {2, 3}, {3, 4}},
+		},
+		{
+			name: "window the size of the input is visited once",
+			args: args{
+				input: []int{1, 2, 3},
+				size:  3,
+			},
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "window larger than the input is not visited",
+			args: args{
+				input: []int{1, 2, 3},
+				size:  4,
+			},
+			want: nil,
+		},
+		{
+			name: "zero size is not visited",
+			args: args{
+				input: []int{1, 2, 3},
+				size:  0,
+			},
+			want: nil,
+		},
+		{
+			name: "negative size is not visited",
+			args: args{
+				input: []int{1, 2, 3},
+				size:  -1,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input is not visited",
+			args: args{
+				input: nil,
+				size:  1,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got [][]int
+			slices.EachWindow(tt.args.input, tt.args.size, func(window []int) {
+				got = append(got, slices.Copy(window))
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EachWindow() visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkEachWindow(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slices.EachWindow(bm.sli, 5, func(window []int) {
+					_ = slices.Sum(window)
+				})
+			}
+		})
+	}
+}
+
+func ExampleForEachReverse() {
+	stack := []string{"oldest", "older", "newest"}
+
+	slices.ForEachReverse(stack, func(element string) {
+		fmt.Println(element)
+	})
+
+	// Output:
+	// newest
+	// older
+	// oldest
+}
+
+func TestForEachReverse(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "visits elements from last to first",
+			args: args{
+				input: []int{1, 2, 3},
+			},
+			want: []int{3, 2, 1},
+		},
+		{
+			name: "nil input is not visited",
+			args: args{
+				input: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			slices.ForEachReverse(tt.args.input, func(element int) {
+				got = append(got, element)
+			})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ForEachReverse() visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleForEachReverseWithIndex() {
+	stack := []string{"oldest", "older", "newest"}
+
+	slices.ForEachReverseWithIndex(stack, func(idx int, element string) {
+		fmt.Printf("%v: %v\n", idx, element)
+	})
+
+	// Output:
+	// 2: newest
+	// 1: older
+	// 0: oldest
+}
+
+func TestForEachReverseWithIndex(t *testing.T) {
+	type args struct {
+		input []string
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantIndexes []int
+		wantValues  []string
+	}{
+		{
+			name: "visits elements from last to first with counting down indexes",
+			args: args{
+				input: []string{"a", "b", "c"},
+			},
+			wantIndexes: []int{2, 1, 0},
+			wantValues:  []string{"c", "b", "a"},
+		},
+		{
+			name: "empty input is not visited",
+			args: args{
+				input: []string{},
+			},
+			wantIndexes: nil,
+			wantValues:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotIndexes []int
+			var gotValues []string
+			slices.ForEachReverseWithIndex(tt.args.input, func(idx int, element string) {
+				gotIndexes = append(gotIndexes, idx)
+				gotValues = append(gotValues, element)
+			})
+			if !reflect.DeepEqual(gotIndexes, tt.wantIndexes) {
+				t.Errorf("ForEachReverseWithIndex() visited indexes %v, want %v", gotIndexes, tt.wantIndexes)
+			}
+			if !reflect.DeepEqual(gotValues, tt.wantValues) {
+				t.Errorf("ForEachReverseWithIndex() visited values %v, want %v", gotValues, tt.wantValues)
+			}
+		})
+	}
+}
@@ -5,6 +5,24 @@ import (
 	"strings"
 )
 
+// BuildFunc is a function which writes an element of a slice to a shared strings.Builder.
+type BuildFunc[T any] func(sb *strings.Builder, element T)
+
+// BuildString creates a new string by calling the provided function with each element of the input in turn, along with
+// a single shared strings.Builder for the function to write to.  This avoids the repeated copying of concatenating
+// strings, and allows the output for each element to differ, unlike JoinToString.  If the input is empty or nil, the
+// output will be an empty string.  Panics if the function is nil.
+func BuildString[T any](input []T, fn BuildFunc[T]) string {
+	if fn == nil {
+		panic("slices.BuildString: fn must not be nil")
+	}
+	var sb strings.Builder
+	for _, element := range input {
+		fn(&sb, element)
+	}
+	return sb.String()
+}
+
 // Concatenate joins two slices together, with inputA being joined with inputB following its last element.
 func Concatenate[T any](inputA, inputB []T) []T {
 	return append(inputA, inputB...)
@@ -62,6 +80,19 @@ func FillTo[T any](input []T, value T, toIndex int) []T {
 	return FillFromTo[T](input, value, 0, toIndex)
 }
 
+// Grow ensures the capacity of the input slice allows n more elements to be appended without another allocation,
+// returning a slice with the same length and elements.  If the input already has room for n more elements it is
+// returned as is, otherwise its elements are copied into a new slice with a capacity of at least its length plus n.  A
+// zero or negative n leaves the input unchanged.
+func Grow[T any](input []T, n int) []T {
+	if n <= 0 || cap(input)-len(input) >= n {
+		return input
+	}
+	output := make([]T, len(input), len(input)+n)
+	copy(output, input)
+	return output
+}
+
 // Insert adds the specified elements to the input slice at the specified index, returning the resulting slice.
 func Insert[T any](input []T, startIdx int, elements ...T) []T {
 	if startIdx < 0 || startIdx >= len(input) {
@@ -72,6 +103,32 @@ func Insert[T any](input []T, startIdx int, elements ...T) []T {
 	return output
 }
 
+// Interleave creates a new slice by taking one element from each of the inputs in turn - the first element of each
+// input, then the second element of each, and so on - until every input is exhausted.  Inputs which run out of
+// elements early are skipped from then on, and nil or empty inputs are skipped entirely.  If there are no elements in
+// any input, the output will be nil.
+func Interleave[T any](inputs ...[]T) []T {
+	total, longest := 0, 0
+	for _, input := range inputs {
+		total += len(input)
+		if len(input) > longest {
+			longest = len(input)
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	output := make([]T, 0, total)
+	for i := 0; i < longest; i++ {
+		for _, input := range inputs {
+			if i < len(input) {
+				output = append(output, input[i])
+			}
+		}
+	}
+	return output
+}
+
 // JoinToString creates a new string by stringifying each of the elements within the input, and placing the separator
 // between them in the resulting string.
 func JoinToString[T any](input []T, separator string) string {
@@ -126,3 +183,76 @@ func Push[T any](input []T, newElements ...T) []T {
 func PushFront[T any](input []T, newElements ...T) []T {
 	return append(newElements, input...)
 }
+
+// ReplaceAll creates a new slice which is a copy of the input, with every element equal to oldValue replaced by
+// newValue.  If the input is empty or nil, the output will be nil.
+func ReplaceAll[T comparable](input []T, oldValue, newValue T) []T {
+	return ReplaceFunc(input, func(element T) (T, bool) {
+		return newValue, element == oldValue
+	})
+}
+
+// ReplaceFunc creates a new slice which is a copy of the input, with each element passed to the provided function -
+// where it returns true, the element is replaced by the value it returned.  If the input is empty or nil, the output
+// will be nil.  Panics if the function is nil.
+func ReplaceFunc[T any](input []T, fn func(element T) (T, bool)) []T {
+	if fn == nil {
+		panic("slices.ReplaceFunc: fn must not be nil")
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	output := make([]T, len(input))
+	for idx, element := range input {
+		if replacement, ok := fn(element); ok {
+			output[idx] = replacement
+		} else {
+			output[idx] = element
+		}
+	}
+	return output
+}
+
+// UniqueInPlace removes duplicate elements from the input slice, keeping the first occurrence of each element and
+// preserving their order.  The elements are compacted within the input's backing array rather than copied into a new
+// slice, so the input is overwritten: the returned slice shares its backing array, and the elements of the input
+// beyond the length of the returned slice are left in an unspecified state.  Empty or nil input results in nil.
+func UniqueInPlace[T comparable](input []T) []T {
+	if len(input) == 0 {
+		return nil
+	}
+	seen := make(map[T]struct{}, len(input))
+	kept := 0
+	for _, element := range input {
+		if _, ok := seen[element]; ok {
+			continue
+		}
+		seen[element] = struct{}{}
+		input[kept] = element
+		kept++
+	}
+	return input[:kept]
+}
+
+// UniqueLast creates a new slice holding each distinct element of the input once, keeping the last occurrence of each
+// element rather than the first.  The kept elements are in the order of those last occurrences, so that later entries
+// supersede earlier ones.  The input is not modified.  Empty or nil input results in nil.
+func UniqueLast[T comparable](input []T) []T {
+	if len(input) == 0 {
+		return nil
+	}
+	seen := make(map[T]struct{}, len(input))
+	var output []T
+	for i := len(input) - 1; i >= 0; i-- {
+		element := input[i]
+		if _, ok := seen[element]; ok {
+			continue
+		}
+		seen[element] = struct{}{}
+		output = append(output, element)
+	}
+	for i, j := 0, len(output)-1; i < j; i, j = i+1, j-1 {
+		output[i], output[j] = output[j], output[i]
+	}
+	return output
+}
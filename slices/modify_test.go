@@ -4,9 +4,104 @@ import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
+func ExampleBuildString() {
+	type task struct {
+		name string
+		done bool
+	}
+	tasks := []task{{"write", true}, {"review", false}}
+
+	out := slices.BuildString(tasks, func(sb *strings.Builder, element task) {
+		if element.done {
+			sb.WriteString("[x] ")
+		} else {
+			sb.WriteString("[ ] ")
+		}
+		sb.WriteString(element.name)
+		sb.WriteString("\n")
+	})
+
+	fmt.Print(out)
+	// Output:
+	// [x] write
+	// [ ] review
+}
+
+func TestBuildString(t *testing.T) {
+	writeWithSign := func(sb *strings.Builder, element int) {
+		if element < 0 {
+			sb.WriteString("-")
+		} else {
+			sb.WriteString("+")
+		}
+		sb.WriteString(strconv.Itoa(element))
+	}
+	type args struct {
+		input []int
+		fn    slices.BuildFunc[int]
+	}
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "writes every element to the builder in order",
+			args: args{
+				input: []int{1, -2, 3},
+				fn:    writeWithSign,
+			},
+			want: "+1--2+3",
+		},
+		{
+			name: "nil input provides an empty string",
+			args: args{
+				input: nil,
+				fn:    writeWithSign,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.BuildString(tt.args.input, tt.args.fn); got != tt.want {
+				t.Errorf("BuildString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkBuildString(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.BuildString(bm.sli, func(sb *strings.Builder, element int) {
+					sb.WriteString(strconv.Itoa(element))
+					sb.WriteByte('\n')
+				})
+			}
+		})
+	}
+}
+
 func ExampleConcatenate() {
 	a := []int{1, 2, 3}
 	b := []int{4, 5, 6}
@@ -906,6 +1001,82 @@ func BenchmarkFillTo(b *testing.B) {
 	}
 }
 
+func ExampleGrow() {
+	sli := slices.Grow([]int{1, 2}, 10)
+
+	fmt.Printf("len: %v, room for ten more: %v, slice: %v", len(sli), cap(sli)-len(sli) >= 10, sli)
+	// Output: len: 2, room for ten more: true, slice: [1 2]
+}
+
+func TestGrow(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		n     int
+	}
+	type testCase[T any] struct {
+		name        string
+		args        args[T]
+		want        []T
+		wantMinCap  int
+		wantSameArr bool
+	}
+	tests := []testCase[int]{
+		{
+			name: "grows capacity and keeps elements",
+			args: args[int]{
+				input: []int{1, 2, 3},
+				n:     5,
+			},
+			want:       []int{1, 2, 3},
+			wantMinCap: 8,
+		},
+		{
+			name: "enough capacity keeps the same backing array",
+			args: args[int]{
+				input: make([]int, 2, 10),
+				n:     8,
+			},
+			want:        []int{0, 0},
+			wantMinCap:  10,
+			wantSameArr: true,
+		},
+		{
+			name: "negative n keeps the same backing array",
+			args: args[int]{
+				input: []int{1},
+				n:     -3,
+			},
+			want:        []int{1},
+			wantMinCap:  1,
+			wantSameArr: true,
+		},
+		{
+			name: "nil input provides an empty slice with capacity",
+			args: args[int]{
+				input: nil,
+				n:     4,
+			},
+			want:       []int{},
+			wantMinCap: 4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Grow(tt.args.input, tt.args.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Grow() = %v, want %v", got, tt.want)
+			}
+			if cap(got) < tt.wantMinCap {
+				t.Errorf("Grow() capacity = %v, want at least %v", cap(got), tt.wantMinCap)
+			}
+			sameArr := cap(got) > 0 && cap(tt.args.input) > 0 && &got[:1][0] == &tt.args.input[:1][0]
+			if sameArr != tt.wantSameArr {
+				t.Errorf("Grow() reused backing array = %v, want %v", sameArr, tt.wantSameArr)
+			}
+		})
+	}
+}
+
 func ExampleInsert() {
 	sli := []int{1, 2, 3, 4, 5}
 	inserted := slices.Insert(sli, 2, 10, 11, 12)
@@ -1032,6 +1203,71 @@ func BenchmarkInsert(b *testing.B) {
 	}
 }
 
+func ExampleInterleave() {
+	popular := []string{"p1", "p2", "p3"}
+	recent := []string{"r1"}
+	similar := []string{"s1", "s2"}
+
+	blended := slices.Interleave(popular, recent, similar)
+	fmt.Printf("blended: %v", blended)
+	// Output: blended: [p1 r1 s1 p2 s2 p3]
+}
+
+func TestInterleave(t *testing.T) {
+	type args struct {
+		inputs [][]int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "takes one element from each input in turn",
+			args: args{
+				inputs: [][]int{{1, 4}, {2, 5}, {3, 6}},
+			},
+			want: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name: "exhausted inputs are skipped",
+			args: args{
+				inputs: [][]int{{1, 3, 5, 6}, {2, 4}},
+			},
+			want: []int{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name: "nil and empty inputs are skipped",
+			args: args{
+				inputs: [][]int{nil, {1, 3}, {}, {2}},
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "no inputs provides nil output",
+			args: args{
+				inputs: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "only empty inputs provides nil output",
+			args: args{
+				inputs: [][]int{{}, nil},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Interleave(tt.args.inputs...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Interleave() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleJoinToString() {
 	sli := []int{1, 2, 3}
 	result := slices.JoinToString(sli, " + ")
@@ -1614,3 +1850,310 @@ func BenchmarkPushFront(b *testing.B) {
 		})
 	}
 }
+
+func ExampleReplaceAll() {
+	readings := []int{3, -1, 5, -1}
+
+	normalised := slices.ReplaceAll(readings, -1, 0)
+	fmt.Printf("normalised: %v, original: %v", normalised, readings)
+	// Output: normalised: [3 0 5 0], original: [3 -1 5 -1]
+}
+
+func TestReplaceAll(t *testing.T) {
+	type args struct {
+		input    []string
+		oldValue string
+		newValue string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "replaces every matching element",
+			args: args{
+				input:    []string{"a", "b", "a", "c"},
+				oldValue: "a",
+				newValue: "z",
+			},
+			want: []string{"z", "b", "z", "c"},
+		},
+		{
+			name: "no matching elements provides a copy",
+			args: args{
+				input:    []string{"a", "b"},
+				oldValue: "x",
+				newValue: "z",
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "nil input provides nil output",
+			args: args{
+				input:    nil,
+				oldValue: "a",
+				newValue: "z",
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.ReplaceAll(tt.args.input, tt.args.oldValue, tt.args.newValue)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReplaceAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleReplaceFunc() {
+	scores := []int{45, 80, 12, 99}
+
+	capped := slices.ReplaceFunc(scores, func(element int) (int, bool) {
+		return 50, element > 50
+	})
+	fmt.Printf("capped: %v", capped)
+	// Output: capped: [45 50 12 50]
+}
+
+func TestReplaceFunc(t *testing.T) {
+	negateOdd := func(element int) (int, bool) {
+		return -element, element%2 != 0
+	}
+	type args struct {
+		input []int
+		fn    func(int) (int, bool)
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "replaces elements where the function returns true",
+			args: args{
+				input: []int{1, 2, 3, 4},
+				fn:    negateOdd,
+			},
+			want: []int{-1, 2, -3, 4},
+		},
+		{
+			name: "empty input provides nil output",
+			args: args{
+				input: []int{},
+				fn:    negateOdd,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append(make([]int, 0, len(tt.args.input)), tt.args.input...)
+			got := slices.ReplaceFunc(tt.args.input, tt.args.fn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReplaceFunc() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.args.input, input) {
+				t.Errorf("ReplaceFunc() modified the input to %v", tt.args.input)
+			}
+		})
+	}
+}
+
+func ExampleUniqueInPlace() {
+	input := []int{3, 1, 3, 2, 1}
+	output := slices.UniqueInPlace(input)
+
+	fmt.Printf("output: %v", output)
+	// Output: output: [3 1 2]
+}
+
+func TestUniqueInPlace(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "removes duplicates keeping first occurrences in order",
+			args: args{
+				input: []int{1, 2, 1, 3, 2, 4},
+			},
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			name: "input without duplicates is unchanged",
+			args: args{
+				input: []int{1, 2, 3},
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "input of one repeated value results in one element",
+			args: args{
+				input: []int{5, 5, 5},
+			},
+			want: []int{5},
+		},
+		{
+			name: "nil input results in nil output",
+			args: args{
+				input: nil,
+			},
+			want: nil,
+		},
+		{
+			name: "empty input results in nil output",
+			args: args{
+				input: []int{},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.UniqueInPlace(tt.args.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqueInPlace() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 && &got[0] != &tt.args.input[0] {
+				t.Errorf("UniqueInPlace() did not reuse the backing array of the input")
+			}
+		})
+	}
+}
+
+func BenchmarkUniqueInPlace(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 1},
+		},
+		{
+			name: "10 elements",
+			sli:  append(slices.Generate(5, slices.NumericIdentityGenerator[int]), slices.Generate(5, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "100 elements",
+			sli:  append(slices.Generate(50, slices.NumericIdentityGenerator[int]), slices.Generate(50, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "1_000 elements",
+			sli:  append(slices.Generate(500, slices.NumericIdentityGenerator[int]), slices.Generate(500, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "10_000 elements",
+			sli:  append(slices.Generate(5_000, slices.NumericIdentityGenerator[int]), slices.Generate(5_000, slices.NumericIdentityGenerator[int])...),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				input := slices.Copy(bm.sli)
+				b.StartTimer()
+				_ = slices.UniqueInPlace(input)
+			}
+		})
+	}
+}
+
+func ExampleUniqueLast() {
+	changedKeys := []string{"name", "email", "name", "role", "email"}
+
+	unique := slices.UniqueLast(changedKeys)
+	fmt.Printf("unique: %v", unique)
+	// Output: unique: [name role email]
+}
+
+func TestUniqueLast(t *testing.T) {
+	type args[T comparable] struct {
+		input []T
+	}
+	type testCase[T comparable] struct {
+		name      string
+		args      args[T]
+		want      []T
+		wantInput []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "keeps the last occurrence of each element",
+			args: args[int]{
+				input: []int{1, 2, 1, 3, 2},
+			},
+			want:      []int{1, 3, 2},
+			wantInput: []int{1, 2, 1, 3, 2},
+		},
+		{
+			name: "no duplicates keeps every element",
+			args: args[int]{
+				input: []int{3, 1, 2},
+			},
+			want:      []int{3, 1, 2},
+			wantInput: []int{3, 1, 2},
+		},
+		{
+			name: "every element the same keeps one",
+			args: args[int]{
+				input: []int{7, 7, 7},
+			},
+			want:      []int{7},
+			wantInput: []int{7, 7, 7},
+		},
+		{
+			name: "nil input results in nil",
+			args: args[int]{
+				input: nil,
+			},
+			want:      nil,
+			wantInput: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.UniqueLast(tt.args.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("UniqueLast() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.args.input, tt.wantInput) {
+				t.Errorf("UniqueLast() modified input to %v, want %v", tt.args.input, tt.wantInput)
+			}
+		})
+	}
+}
+
+func BenchmarkUniqueLast(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 1},
+		},
+		{
+			name: "100 elements",
+			sli:  append(slices.Generate(50, slices.NumericIdentityGenerator[int]), slices.Generate(50, slices.NumericIdentityGenerator[int])...),
+		},
+		{
+			name: "10_000 elements",
+			sli:  append(slices.Generate(5_000, slices.NumericIdentityGenerator[int]), slices.Generate(5_000, slices.NumericIdentityGenerator[int])...),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.UniqueLast(bm.sli)
+			}
+		})
+	}
+}
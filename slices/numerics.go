@@ -16,6 +16,33 @@ func (n NumericSlice[T]) Max() T {
 	return Max(n)
 }
 
+// MinMax finds both the minimum and maximum values in the input in a single pass, comparing the elements in pairs so
+// that roughly 1.5 comparisons are made per element, rather than the 2 needed by separate calls to Min and Max.  Empty
+// or nil input results in zero values, with ok set to false.
+func MinMax[T constraints.Ordered](input []T) (min, max T, ok bool) {
+	if len(input) == 0 {
+		return
+	}
+	min, max = input[0], input[0]
+	for i := 1; i < len(input); i += 2 {
+		lo, hi := input[i], input[i]
+		if i+1 < len(input) {
+			if next := input[i+1]; next < lo {
+				lo = next
+			} else {
+				hi = next
+			}
+		}
+		if lo < min {
+			min = lo
+		}
+		if hi > max {
+			max = hi
+		}
+	}
+	return min, max, true
+}
+
 // Min finds the minimum value in the input, returning the result.  Empty or nil input results in max int value.
 func (n NumericSlice[T]) Min() T {
 	return Min(n)
@@ -38,6 +65,73 @@ func Avg[T constraints.Numeric](input []T) float64 {
 	return float64(total) / float64(len(input))
 }
 
+// Clamp bounds the value into the range between lo and hi (inclusive), returning lo if the value is below the range and
+// hi if it is above it.
+func Clamp[T constraints.Ordered](value, lo, hi T) T {
+	if value < lo {
+		return lo
+	}
+	if value > hi {
+		return hi
+	}
+	return value
+}
+
+// ClampAll creates a new slice in which each element of the input is bounded into the range between lo and hi
+// (inclusive), returning the result.  Empty or nil input results in nil.
+func ClampAll[T constraints.Ordered](input []T, lo, hi T) []T {
+	if len(input) == 0 {
+		return nil
+	}
+	inputCpy := Copy(input)
+	ClampAllInPlace(inputCpy, lo, hi)
+	return inputCpy
+}
+
+// ClampAllInPlace bounds each element of the input slice into the range between lo and hi (inclusive).  The input
+// slice is modified, with no copy being made.
+func ClampAllInPlace[T constraints.Ordered](input []T, lo, hi T) {
+	for idx, element := range input {
+		input[idx] = Clamp(element, lo, hi)
+	}
+}
+
+// Histogram counts the elements of the input into the given number of equal-width buckets spanning [min, max],
+// returning the count of each bucket in order.  Each bucket includes its lower bound and excludes its upper bound,
+// except for the last bucket, which also includes max.  Values below min, including negative infinity, are counted in
+// the first bucket and values above max, including positive infinity, in the last.  NaN elements fall in no bucket and
+// are skipped, so every other element is counted exactly once.  If buckets is zero or less, or max is not greater than
+// min, the output will be nil.
+func Histogram[T constraints.Numeric](input []T, min, max T, buckets int) []int {
+	if buckets <= 0 || !(max > min) {
+		return nil
+	}
+	counts := make([]int, buckets)
+	// Halving both bounds keeps the span finite even when max - min overflows, such as for the full float64 range.
+	lo := float64(min) / 2
+	span := float64(max)/2 - lo
+	for _, element := range input {
+		if element != element {
+			continue
+		}
+		idx := 0
+		switch {
+		case element <= min:
+		case element >= max:
+			idx = buckets - 1
+		default:
+			idx = int((float64(element)/2 - lo) / span * float64(buckets))
+			if idx < 0 {
+				idx = 0
+			} else if idx >= buckets {
+				idx = buckets - 1
+			}
+		}
+		counts[idx]++
+	}
+	return counts
+}
+
 // Max finds the maximum value in the input, returning the result.  Empty or nil input results in zero.
 func Max[T constraints.Ordered](input []T) T {
 	var result T
@@ -63,6 +157,17 @@ func Min[T constraints.Ordered](input []T) T {
 	return result
 }
 
+// Product multiplies together each element of the input slice, returning the total result.  Empty or nil input results
+// in one, the multiplicative identity, which is the untyped constant 1 converted to the element type - so 1 for integer
+// types and 1.0 for float types.  As with Sum, integer results silently overflow.
+func Product[T constraints.Numeric](input []T) T {
+	var result T = 1
+	for _, element := range input {
+		result *= element
+	}
+	return result
+}
+
 // Sum adds up each element of the input slice, returning the total result.  Empty or nil input results in zero.
 func Sum[T constraints.Numeric](input []T) T {
 	var result T
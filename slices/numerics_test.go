@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/constraints"
 	"github.com/pickeringtech/go-collections/slices"
+	"math"
+	"reflect"
 	"testing"
 )
 
@@ -433,6 +435,374 @@ func BenchmarkAvg(b *testing.B) {
 	}
 }
 
+func ExampleClamp() {
+	fmt.Printf("below: %v, within: %v, above: %v", slices.Clamp(-5, 0, 10), slices.Clamp(5, 0, 10), slices.Clamp(15, 0, 10))
+	// Output: below: 0, within: 5, above: 10
+}
+
+func TestClamp(t *testing.T) {
+	type args struct {
+		value int
+		lo    int
+		hi    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "value below the range is raised to lo",
+			args: args{value: -5, lo: 0, hi: 10},
+			want: 0,
+		},
+		{
+			name: "value within the range is unchanged",
+			args: args{value: 5, lo: 0, hi: 10},
+			want: 5,
+		},
+		{
+			name: "value above the range is lowered to hi",
+			args: args{value: 15, lo: 0, hi: 10},
+			want: 10,
+		},
+		{
+			name: "values on the bounds are unchanged",
+			args: args{value: 10, lo: 0, hi: 10},
+			want: 10,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Clamp(tt.args.value, tt.args.lo, tt.args.hi)
+			if got != tt.want {
+				t.Errorf("Clamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleClampAll() {
+	readings := []float64{-3.5, 12.25, 101, 64}
+
+	bounded := slices.ClampAll(readings, 0, 100)
+	fmt.Printf("bounded: %v, readings: %v", bounded, readings)
+	// Output: bounded: [0 12.25 100 64], readings: [-3.5 12.25 101 64]
+}
+
+func TestClampAll(t *testing.T) {
+	type args struct {
+		input []int
+		lo    int
+		hi    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "bounds each element into the range",
+			args: args{
+				input: []int{-10, 0, 5, 10, 20},
+				lo:    0,
+				hi:    10,
+			},
+			want: []int{0, 0, 5, 10, 10},
+		},
+		{
+			name: "nil input results in nil output",
+			args: args{
+				input: nil,
+				lo:    0,
+				hi:    10,
+			},
+			want: nil,
+		},
+		{
+			name: "empty input results in nil output",
+			args: args{
+				input: []int{},
+				lo:    0,
+				hi:    10,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origInput := slices.Copy(tt.args.input)
+			got := slices.ClampAll(tt.args.input, tt.args.lo, tt.args.hi)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClampAll() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(tt.args.input, origInput) && len(origInput) > 0 {
+				t.Errorf("ClampAll() changed input to %v, want %v", tt.args.input, origInput)
+			}
+		})
+	}
+}
+
+func BenchmarkClampAll(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.ClampAll(bm.sli, 10, 50)
+			}
+		})
+	}
+}
+
+func ExampleClampAllInPlace() {
+	readings := []int{-3, 12, 101, 64}
+
+	slices.ClampAllInPlace(readings, 0, 100)
+	fmt.Printf("readings: %v", readings)
+	// Output: readings: [0 12 100 64]
+}
+
+func TestClampAllInPlace(t *testing.T) {
+	type args struct {
+		input []int
+		lo    int
+		hi    int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "bounds each element of the input into the range",
+			args: args{
+				input: []int{-10, 0, 5, 10, 20},
+				lo:    0,
+				hi:    10,
+			},
+			want: []int{0, 0, 5, 10, 10},
+		},
+		{
+			name: "nil input remains nil",
+			args: args{
+				input: nil,
+				lo:    0,
+				hi:    10,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slices.ClampAllInPlace(tt.args.input, tt.args.lo, tt.args.hi)
+			if !reflect.DeepEqual(tt.args.input, tt.want) {
+				t.Errorf("ClampAllInPlace() resulted in %v, want %v", tt.args.input, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleHistogram() {
+	responseSizes := []int{120, 480, 510, 999, 1000, 250, 2048}
+
+	counts := slices.Histogram(responseSizes, 0, 1000, 4)
+	fmt.Printf("counts: %v", counts)
+	// Output: counts: [1 2 1 3]
+}
+
+func TestHistogram(t *testing.T) {
+	type args[T constraints.Numeric] struct {
+		input   []T
+		min     T
+		max     T
+		buckets int
+	}
+	type testCase[T constraints.Numeric] struct {
+		name string
+		args args[T]
+		want []int
+	}
+	tests := []testCase[int]{
+		{
+			name: "counts elements into equal-width buckets",
+			args: args[int]{
+				input:   []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+				min:     0,
+				max:     10,
+				buckets: 5,
+			},
+			want: []int{2, 2, 2, 2, 2},
+		},
+		{
+			name: "interior bounds belong to the upper bucket",
+			args: args[int]{
+				input:   []int{24, 25, 49, 50, 75},
+				min:     0,
+				max:     100,
+				buckets: 4,
+			},
+			want: []int{1, 2, 1, 1},
+		},
+		{
+			name: "max belongs to the last bucket",
+			args: args[int]{
+				input:   []int{100},
+				min:     0,
+				max:     100,
+				buckets: 4,
+			},
+			want: []int{0, 0, 0, 1},
+		},
+		{
+			name: "out of range elements are clamped into the edge buckets",
+			args: args[int]{
+				input:   []int{-50, 5, 150, 200},
+				min:     0,
+				max:     100,
+				buckets: 2,
+			},
+			want: []int{2, 2},
+		},
+		{
+			name: "nil input provides empty buckets",
+			args: args[int]{
+				input:   nil,
+				min:     0,
+				max:     10,
+				buckets: 3,
+			},
+			want: []int{0, 0, 0},
+		},
+		{
+			name: "zero buckets provides nil",
+			args: args[int]{
+				input:   []int{1, 2},
+				min:     0,
+				max:     10,
+				buckets: 0,
+			},
+			want: nil,
+		},
+		{
+			name: "max not greater than min provides nil",
+			args: args[int]{
+				input:   []int{1, 2},
+				min:     5,
+				max:     5,
+				buckets: 2,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Histogram(tt.args.input, tt.args.min, tt.args.max, tt.args.buckets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Histogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHistogram_Unsigned(t *testing.T) {
+	got := slices.Histogram([]uint8{1, 10, 19, 20, 255}, 10, 20, 2)
+	want := []int{2, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram() = %v, want %v", got, want)
+	}
+}
+
+func TestHistogram_Float(t *testing.T) {
+	got := slices.Histogram([]float64{0.1, 0.2, 0.3, 0.7, 0.9, 1.0}, 0, 1, 10)
+	want := []int{0, 1, 1, 1, 0, 0, 0, 1, 0, 2}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Histogram() = %v, want %v", got, want)
+	}
+}
+
+func TestHistogram_FloatSpecialValues(t *testing.T) {
+	type args struct {
+		input   []float64
+		min     float64
+		max     float64
+		buckets int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "NaN elements are skipped",
+			args: args{
+				input:   []float64{1, math.NaN(), 2},
+				min:     0,
+				max:     10,
+				buckets: 5,
+			},
+			want: []int{1, 1, 0, 0, 0},
+		},
+		{
+			name: "infinities are counted in the edge buckets",
+			args: args{
+				input:   []float64{math.Inf(-1), 5, math.Inf(1)},
+				min:     0,
+				max:     10,
+				buckets: 2,
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "range wider than the largest float is split evenly",
+			args: args{
+				input:   []float64{-math.MaxFloat64, -1e300, 1e300, math.MaxFloat64},
+				min:     -math.MaxFloat64,
+				max:     math.MaxFloat64,
+				buckets: 2,
+			},
+			want: []int{2, 2},
+		},
+		{
+			name: "NaN bounds provide nil",
+			args: args{
+				input:   []float64{1, 2},
+				min:     math.NaN(),
+				max:     10,
+				buckets: 2,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Histogram(tt.args.input, tt.args.min, tt.args.max, tt.args.buckets)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Histogram() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleMax() {
 	sli := []int{1, 10, 1000, -10, -1, 0, 30}
 
@@ -525,6 +895,119 @@ func BenchmarkMax(b *testing.B) {
 	}
 }
 
+func ExampleMinMax() {
+	sli := []int{1, 10, 1000, -10, -1, 0, 30}
+
+	min, max, ok := slices.MinMax(sli)
+	fmt.Printf("min: %v, max: %v, ok: %v", min, max, ok)
+	// Output: min: -10, max: 1000, ok: true
+}
+
+func TestMinMax(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantMin int
+		wantMax int
+		wantOk  bool
+	}{
+		{
+			name: "finds the smallest and largest elements in an even length input",
+			args: args{
+				input: []int{3, 2, 1, 1, 5, 0, -3, 4},
+			},
+			wantMin: -3,
+			wantMax: 5,
+			wantOk:  true,
+		},
+		{
+			name: "finds the smallest and largest elements in an odd length input",
+			args: args{
+				input: []int{3, 2, 1, 9, -5},
+			},
+			wantMin: -5,
+			wantMax: 9,
+			wantOk:  true,
+		},
+		{
+			name: "single element is both the smallest and largest",
+			args: args{
+				input: []int{7},
+			},
+			wantMin: 7,
+			wantMax: 7,
+			wantOk:  true,
+		},
+		{
+			name: "negative elements are not compared against zero",
+			args: args{
+				input: []int{-4, -2, -9},
+			},
+			wantMin: -9,
+			wantMax: -2,
+			wantOk:  true,
+		},
+		{
+			name: "nil input is not ok",
+			args: args{
+				input: nil,
+			},
+			wantOk: false,
+		},
+		{
+			name: "empty input is not ok",
+			args: args{
+				input: []int{},
+			},
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMin, gotMax, gotOk := slices.MinMax(tt.args.input)
+			if gotMin != tt.wantMin {
+				t.Errorf("MinMax() gotMin = %v, want %v", gotMin, tt.wantMin)
+			}
+			if gotMax != tt.wantMax {
+				t.Errorf("MinMax() gotMax = %v, want %v", gotMax, tt.wantMax)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("MinMax() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func BenchmarkMinMax(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _, _ = slices.MinMax(bm.sli)
+			}
+		})
+	}
+}
+
 func ExampleMin() {
 	sli := []int{1, 10, 1000, -10, -1, 0, 30}
 
@@ -617,6 +1100,78 @@ func BenchmarkMin(b *testing.B) {
 	}
 }
 
+func ExampleProduct() {
+	probabilities := []float64{0.5, 0.5, 0.25}
+
+	product := slices.Product(probabilities)
+	fmt.Printf("product: %v", product)
+	// Output: product: 0.0625
+}
+
+func TestProduct(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "results multiply to expected amount",
+			args: args{
+				input: []int{1, 2, 3, 4, 5},
+			},
+			want: 120,
+		},
+		{
+			name: "zero element results in zero",
+			args: args{
+				input: []int{3, 0, 7},
+			},
+			want: 0,
+		},
+		{
+			name: "negative elements keep their sign",
+			args: args{
+				input: []int{-2, 3},
+			},
+			want: -6,
+		},
+		{
+			name: "nil input results in one",
+			args: args{
+				input: nil,
+			},
+			want: 1,
+		},
+		{
+			name: "empty input results in one",
+			args: args{
+				input: []int{},
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Product(tt.args.input)
+			if got != tt.want {
+				t.Errorf("Product() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProduct_Float(t *testing.T) {
+	if got := slices.Product([]float32{}); got != 1.0 {
+		t.Errorf("Product() = %v, want 1.0", got)
+	}
+	if got := slices.Product([]float64{0.5, 4}); got != 2.0 {
+		t.Errorf("Product() = %v, want 2.0", got)
+	}
+}
+
 func ExampleSum() {
 	sli := []int{1, 2, 3, 4, 5}
 
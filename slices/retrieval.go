@@ -1,8 +1,17 @@
 package slices
 
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+)
+
 // AllMatch tests each element of the input with the provided function.  If all the elements, when passed through the
 // function result in a truthy boolean value, true is returned from this function.  Otherwise, false is returned.
+// Panics if the function is nil.
 func AllMatch[T any](input []T, fun FindFunc[T]) bool {
+	if fun == nil {
+		panic("slices.AllMatch: fun must not be nil")
+	}
 	if len(input) == 0 {
 		return false
 	}
@@ -14,10 +23,26 @@ func AllMatch[T any](input []T, fun FindFunc[T]) bool {
 	return true
 }
 
+// AllUnique determines whether every element of the input slice is distinct, stopping at the first duplicate found.
+// Empty, nil or single element input results in true.
+func AllUnique[T comparable](input []T) bool {
+	seen := make(map[T]struct{}, len(input))
+	for _, element := range input {
+		if _, ok := seen[element]; ok {
+			return false
+		}
+		seen[element] = struct{}{}
+	}
+	return true
+}
+
 // AnyMatch tests each element of the input with the provided function.  If any of the elements, when passed through the
 // function result in a truthy boolean value, a match is found and true is returned from this function.  Otherwise, false
-// is returned.
+// is returned.  Panics if the function is nil.
 func AnyMatch[T any](input []T, fun FindFunc[T]) bool {
+	if fun == nil {
+		panic("slices.AnyMatch: fun must not be nil")
+	}
 	for _, element := range input {
 		if fun(element) {
 			return true
@@ -26,13 +51,75 @@ func AnyMatch[T any](input []T, fun FindFunc[T]) bool {
 	return false
 }
 
+// IndexOutOfRangeError is returned by At when the index is outside the bounds of the slice.
+type IndexOutOfRangeError struct {
+	// Index is the index which was requested.
+	Index int
+	// Length is the length of the slice at the time.
+	Length int
+}
+
+// Error describes the requested index along with the length of the slice.
+func (e IndexOutOfRangeError) Error() string {
+	return fmt.Sprintf("slices: index %d out of range for length %d", e.Index, e.Length)
+}
+
+// At provides the element of the input slice at the specified index.  If the index is negative, or not less than the
+// length of the input, an IndexOutOfRangeError is returned along with the zero value.
+func At[T any](input []T, index int) (T, error) {
+	if index < 0 || index >= len(input) {
+		var zero T
+		return zero, IndexOutOfRangeError{Index: index, Length: len(input)}
+	}
+	return input[index], nil
+}
+
+// Coalesce provides the first of the given values which is not the zero value of its type.  If every value is the zero
+// value, or no values are given, the zero value is returned.
+func Coalesce[T comparable](values ...T) T {
+	var zero T
+	for _, value := range values {
+		if value != zero {
+			return value
+		}
+	}
+	return zero
+}
+
+// CoalesceFunc provides the first of the given values for which the isEmpty function returns false.  If every value is
+// considered empty, or no values are given, the zero value is returned.  Panics if the function is nil.
+func CoalesceFunc[T any](isEmpty FindFunc[T], values ...T) (result T) {
+	if isEmpty == nil {
+		panic("slices.CoalesceFunc: isEmpty must not be nil")
+	}
+	for _, value := range values {
+		if !isEmpty(value) {
+			return value
+		}
+	}
+	return
+}
+
+// CountDistinct provides the number of unique elements within the input slice, without building a slice of those
+// elements.  Empty or nil input results in zero.
+func CountDistinct[T comparable](input []T) int {
+	seen := map[T]struct{}{}
+	for _, element := range input {
+		seen[element] = struct{}{}
+	}
+	return len(seen)
+}
+
 // FindFunc is a function which can be used to test an element in a slice.  It receives the element in the slice and
 // returns a boolean value indicating whether the element is a match.
 type FindFunc[T any] func(T) bool
 
 // Find tests each element of the input with the provided function.  If the function returns true, the selected element
-// is returned, along with a boolean truthy value.
+// is returned, along with a boolean truthy value.  Panics if the function is nil.
 func Find[T any](input []T, fun FindFunc[T]) (result T, ok bool) {
+	if fun == nil {
+		panic("slices.Find: fun must not be nil")
+	}
 	for _, element := range input {
 		if fun(element) {
 			return element, true
@@ -42,8 +129,11 @@ func Find[T any](input []T, fun FindFunc[T]) (result T, ok bool) {
 }
 
 // FindIndex tests each element of input with the provided testing function, and returns the index of the first element
-// that satisfies the testing function.  If no matches are found, -1 is returned.
+// that satisfies the testing function.  If no matches are found, -1 is returned.  Panics if the function is nil.
 func FindIndex[T any](input []T, fun FindFunc[T]) int {
+	if fun == nil {
+		panic("slices.FindIndex: fun must not be nil")
+	}
 	for idx, element := range input {
 		if fun(element) {
 			return idx
@@ -54,7 +144,11 @@ func FindIndex[T any](input []T, fun FindFunc[T]) int {
 
 // FindLast tests each element of the input with the provided function, starting from the end and working background.
 // If the function returns true, the selected element is returned, along with a boolean truthy value.
+// Panics if the function is nil.
 func FindLast[T any](input []T, fun FindFunc[T]) (result T, ok bool) {
+	if fun == nil {
+		panic("slices.FindLast: fun must not be nil")
+	}
 	for i := len(input) - 1; i >= 0; i-- {
 		element := input[i]
 		if fun(element) {
@@ -65,8 +159,11 @@ func FindLast[T any](input []T, fun FindFunc[T]) (result T, ok bool) {
 }
 
 // FindLastIndex tests each element of input with the provided testing function, and returns the index of the last element
-// that satisfies the testing function.  If no matches are found, -1 is returned.
+// that satisfies the testing function.  If no matches are found, -1 is returned.  Panics if the function is nil.
 func FindLastIndex[T any](input []T, fun FindFunc[T]) int {
+	if fun == nil {
+		panic("slices.FindLastIndex: fun must not be nil")
+	}
 	for i := len(input) - 1; i >= 0; i-- {
 		element := input[i]
 		if fun(element) {
@@ -76,6 +173,33 @@ func FindLastIndex[T any](input []T, fun FindFunc[T]) int {
 	return -1
 }
 
+// FindOr tests each element of the input with the provided function, returning the first element which satisfies the
+// function.  If no matches are found, the default value is returned.  Panics if the function is nil.
+func FindOr[T any](input []T, fun FindFunc[T], defaultValue T) T {
+	if fun == nil {
+		panic("slices.FindOr: fun must not be nil")
+	}
+	if result, ok := Find(input, fun); ok {
+		return result
+	}
+	return defaultValue
+}
+
+// FindWithIndex tests each element of the input with the provided function, returning the index and value of the first
+// element that satisfies the function, along with a boolean truthy value.  If no matches are found, -1, the zero value
+// and false are returned.  Panics if the function is nil.
+func FindWithIndex[T any](input []T, fun FindFunc[T]) (index int, result T, ok bool) {
+	if fun == nil {
+		panic("slices.FindWithIndex: fun must not be nil")
+	}
+	for idx, element := range input {
+		if fun(element) {
+			return idx, element, true
+		}
+	}
+	return -1, result, false
+}
+
 // First provides the first element of the input slice.  If there is no possible element to return, a boolean false value
 // is provided as the ok named return value.
 func First[T any](input []T) (result T, ok bool) {
@@ -87,6 +211,26 @@ func First[T any](input []T) (result T, ok bool) {
 	return
 }
 
+// FirstN provides a copy of the first n elements of the input slice.  If n is greater than the length of the input, a
+// copy of the whole input is provided.  If n is zero or less, or the input is empty or nil, the output will be nil.
+func FirstN[T any](input []T, n int) []T {
+	if n <= 0 || len(input) == 0 {
+		return nil
+	}
+	if n > len(input) {
+		n = len(input)
+	}
+	return Copy(input[:n])
+}
+
+// FirstOr provides the first element of the input slice.  If the input is empty or nil, the default value is returned.
+func FirstOr[T any](input []T, defaultValue T) T {
+	if len(input) == 0 {
+		return defaultValue
+	}
+	return input[0]
+}
+
 // Get provides the element of the input slice at the specified index.  If the index is out of bounds, the default value
 // is returned.
 func Get[T any](input []T, index int, defaultValue T) T {
@@ -117,17 +261,100 @@ func IndexOf[T comparable](input []T, value T) int {
 	return -1
 }
 
+// IndexOfSubslice returns the index at which the first occurrence of the needle slice can be found as a contiguous run
+// of elements within the haystack slice, or -1 if it is not present.  An empty or nil needle is found at index 0.
+func IndexOfSubslice[T comparable](haystack, needle []T) int {
+	if len(needle) == 0 {
+		return 0
+	}
+	for start := 0; start+len(needle) <= len(haystack); start++ {
+		if haystack[start] != needle[0] {
+			continue
+		}
+		matched := true
+		for offset := 1; offset < len(needle); offset++ {
+			if haystack[start+offset] != needle[offset] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return start
+		}
+	}
+	return -1
+}
+
 // IsEmpty determines whether the input slice is empty.  If it is, a truthy boolean is returned.  Otherwise, a falsy
 // boolean is returned.
 func IsEmpty[T any](input []T) bool {
 	return len(input) == 0
 }
 
+// LastN provides a copy of the last n elements of the input slice, in their original order.  If n is greater than the
+// length of the input, a copy of the whole input is provided.  If n is zero or less, or the input is empty or nil, the
+// output will be nil.
+func LastN[T any](input []T, n int) []T {
+	if n <= 0 || len(input) == 0 {
+		return nil
+	}
+	if n > len(input) {
+		n = len(input)
+	}
+	return Copy(input[len(input)-n:])
+}
+
+// LastOr provides the last element of the input slice.  If the input is empty or nil, the default value is returned.
+func LastOr[T any](input []T, defaultValue T) T {
+	if len(input) == 0 {
+		return defaultValue
+	}
+	return input[len(input)-1]
+}
+
 // Length provides the length of the input slice.
 func Length[T any](input []T) int {
 	return len(input)
 }
 
+// LongestRun finds the longest run of equal consecutive elements within the input slice in a single pass, providing
+// the value of the elements in the run, its length, and the index at which it starts.  If there are several runs of
+// the longest length, the first is provided.  Empty or nil input results in the zero value, a length of 0 and a start
+// index of -1.
+func LongestRun[T comparable](input []T) (value T, length int, startIndex int) {
+	if len(input) == 0 {
+		return value, 0, -1
+	}
+	value, length, startIndex = input[0], 1, 0
+	runStart := 0
+	for idx := 1; idx < len(input); idx++ {
+		if input[idx] != input[runStart] {
+			runStart = idx
+			continue
+		}
+		if runLength := idx - runStart + 1; runLength > length {
+			value, length, startIndex = input[runStart], runLength, runStart
+		}
+	}
+	return value, length, startIndex
+}
+
+// Matches tests each element of the input with the provided function, returning the index and value of every element
+// which satisfies it, in order.  Each match is given as a maps.Entry, keyed by its index.  If no matches are found, or
+// the input is empty or nil, the output will be nil.  Panics if the function is nil.
+func Matches[T any](input []T, fun FindFunc[T]) []maps.Entry[int, T] {
+	if fun == nil {
+		panic("slices.Matches: fun must not be nil")
+	}
+	var results []maps.Entry[int, T]
+	for idx, element := range input {
+		if fun(element) {
+			results = append(results, maps.Entry[int, T]{Key: idx, Value: element})
+		}
+	}
+	return results
+}
+
 // PeekEnd provides the last element of the input slice.  If there is no possible element to return, a boolean false
 // value is provided as the ok named return value.
 func PeekEnd[T any](input []T) (lastElement T, ok bool) {
@@ -173,3 +400,37 @@ func SubSlice[T any](input []T, fromIndex, toIndex int) []T {
 	}
 	return input[fromIndex:toIndex]
 }
+
+// SubSliceStep provides a new slice containing every step-th entry between the two indexes of the input slice (from is
+// inclusive, to is exclusive).  A negative step walks backward from the from index down to the to index, producing the
+// entries in reverse order.  Out of range indexes are clamped to the bounds of the input, as with SubSlice.  A step of
+// zero, or a range containing no entries, results in nil.
+func SubSliceStep[T any](input []T, fromIndex, toIndex, step int) []T {
+	l := len(input)
+	if l == 0 || step == 0 {
+		return nil
+	}
+	var results []T
+	if step > 0 {
+		if fromIndex < 0 {
+			fromIndex = 0
+		}
+		if toIndex > l {
+			toIndex = l
+		}
+		for i := fromIndex; i < toIndex; i += step {
+			results = append(results, input[i])
+		}
+		return results
+	}
+	if fromIndex >= l {
+		fromIndex = l - 1
+	}
+	if toIndex < -1 {
+		toIndex = -1
+	}
+	for i := fromIndex; i > toIndex; i += step {
+		results = append(results, input[i])
+	}
+	return results
+}
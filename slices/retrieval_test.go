@@ -2,8 +2,10 @@ package slices_test
 
 import (
 	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -199,6 +201,96 @@ func BenchmarkAllMatch(b *testing.B) {
 	}
 }
 
+func ExampleAllUnique() {
+	ids := []string{"a1", "b2", "c3"}
+	duplicated := []string{"a1", "b2", "a1"}
+
+	fmt.Printf("unique: %v, duplicated unique: %v", slices.AllUnique(ids), slices.AllUnique(duplicated))
+	// Output: unique: true, duplicated unique: false
+}
+
+func TestAllUnique(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "distinct elements result in true",
+			args: args{
+				input: []int{1, 2, 3, 4},
+			},
+			want: true,
+		},
+		{
+			name: "duplicated elements result in false",
+			args: args{
+				input: []int{1, 2, 3, 2},
+			},
+			want: false,
+		},
+		{
+			name: "single element results in true",
+			args: args{
+				input: []int{1},
+			},
+			want: true,
+		},
+		{
+			name: "nil input results in true",
+			args: args{
+				input: nil,
+			},
+			want: true,
+		},
+		{
+			name: "empty input results in true",
+			args: args{
+				input: []int{},
+			},
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.AllUnique(tt.args.input)
+			if got != tt.want {
+				t.Errorf("AllUnique() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkAllUnique(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.AllUnique(bm.sli)
+			}
+		})
+	}
+}
+
 func ExampleAnyMatch() {
 	sli := []int{1, 2, 3, 4, 5}
 
@@ -389,6 +481,322 @@ func BenchmarkAnyMatch(b *testing.B) {
 	}
 }
 
+func ExampleAt() {
+	fields := []string{"2024-01-01", "GET", "/index.html"}
+
+	_, err := slices.At(fields, 3)
+	fmt.Println(err)
+	// Output: slices: index 3 out of range for length 3
+}
+
+func TestAt(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		index int
+	}
+	type testCase[T any] struct {
+		name    string
+		args    args[T]
+		want    T
+		wantErr error
+	}
+	tests := []testCase[string]{
+		{
+			name: "provides the element at the index",
+			args: args[string]{
+				input: []string{"a", "b", "c"},
+				index: 1,
+			},
+			want:    "b",
+			wantErr: nil,
+		},
+		{
+			name: "index equal to the length provides an error",
+			args: args[string]{
+				input: []string{"a", "b", "c"},
+				index: 3,
+			},
+			want:    "",
+			wantErr: slices.IndexOutOfRangeError{Index: 3, Length: 3},
+		},
+		{
+			name: "negative index provides an error",
+			args: args[string]{
+				input: []string{"a"},
+				index: -1,
+			},
+			want:    "",
+			wantErr: slices.IndexOutOfRangeError{Index: -1, Length: 1},
+		},
+		{
+			name: "nil input provides an error",
+			args: args[string]{
+				input: nil,
+				index: 0,
+			},
+			want:    "",
+			wantErr: slices.IndexOutOfRangeError{Index: 0, Length: 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slices.At(tt.args.input, tt.args.index)
+			if err != tt.wantErr {
+				t.Errorf("At() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("At() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCoalesce() {
+	flagValue, envValue, defaultValue := "", "from-env", "default"
+
+	setting := slices.Coalesce(flagValue, envValue, defaultValue)
+
+	fmt.Printf("setting: %v", setting)
+	// Output: setting: from-env
+}
+
+func TestCoalesce(t *testing.T) {
+	type args struct {
+		values []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "first value is non-zero",
+			args: args{
+				values: []int{1, 2, 3},
+			},
+			want: 1,
+		},
+		{
+			name: "skips leading zero values",
+			args: args{
+				values: []int{0, 0, 3, 4},
+			},
+			want: 3,
+		},
+		{
+			name: "all zero values results in zero",
+			args: args{
+				values: []int{0, 0, 0},
+			},
+			want: 0,
+		},
+		{
+			name: "no values results in zero",
+			args: args{
+				values: nil,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Coalesce(tt.args.values...)
+			if got != tt.want {
+				t.Errorf("Coalesce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCoalesce(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{0, 0, 3},
+		},
+		{
+			name: "10 elements",
+			sli:  slices.Fill(make([]int, 10), 0),
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Fill(make([]int, 100), 0),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Fill(make([]int, 1_000), 0),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Fill(make([]int, 10_000), 0),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				slices.Coalesce(bm.sli...)
+			}
+		})
+	}
+}
+
+func ExampleCoalesceFunc() {
+	isBlank := func(s string) bool {
+		return strings.TrimSpace(s) == ""
+	}
+
+	setting := slices.CoalesceFunc(isBlank, "  ", "", "fallback")
+
+	fmt.Printf("setting: %v", setting)
+	// Output: setting: fallback
+}
+
+func TestCoalesceFunc(t *testing.T) {
+	type args struct {
+		isEmpty slices.FindFunc[int]
+		values  []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "skips values considered empty",
+			args: args{
+				isEmpty: func(i int) bool {
+					return i < 0
+				},
+				values: []int{-1, -2, 0, 4},
+			},
+			want: 0,
+		},
+		{
+			name: "all empty values results in zero",
+			args: args{
+				isEmpty: func(i int) bool {
+					return i < 0
+				},
+				values: []int{-1, -2},
+			},
+			want: 0,
+		},
+		{
+			name: "no values results in zero",
+			args: args{
+				isEmpty: func(i int) bool {
+					return i < 0
+				},
+				values: nil,
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.CoalesceFunc(tt.args.isEmpty, tt.args.values...)
+			if got != tt.want {
+				t.Errorf("CoalesceFunc() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleCountDistinct() {
+	users := []string{"alice", "bob", "alice", "carol", "bob"}
+
+	distinct := slices.CountDistinct(users)
+
+	fmt.Printf("distinct users: %v", distinct)
+	// Output: distinct users: 3
+}
+
+func TestCountDistinct(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "counts each value once",
+			args: args{
+				input: []int{1, 2, 2, 3, 1, 1},
+			},
+			want: 3,
+		},
+		{
+			name: "all unique values are counted",
+			args: args{
+				input: []int{1, 2, 3, 4},
+			},
+			want: 4,
+		},
+		{
+			name: "nil input results in zero",
+			args: args{
+				input: nil,
+			},
+			want: 0,
+		},
+		{
+			name: "empty input results in zero",
+			args: args{
+				input: []int{},
+			},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.CountDistinct(tt.args.input)
+			if got != tt.want {
+				t.Errorf("CountDistinct() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkCountDistinct(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 1},
+		},
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.CountDistinct(bm.sli)
+			}
+		})
+	}
+}
+
 func ExampleFind() {
 	sli := []int{1, 2, 3, 4, 5}
 
@@ -1135,15 +1543,190 @@ func BenchmarkFindLastIndex(b *testing.B) {
 		{
 			name: "1_000_000 elements no match",
 			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
-			fn: func(i int) bool {
-				return false
-			},
+			fn: func(i int) bool {
+				return false
+			},
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.FindLastIndex(bm.sli, bm.fn)
+			}
+		})
+	}
+}
+
+func ExampleFindOr() {
+	sli := []string{"alpha", "beta", "gamma"}
+
+	found := slices.FindOr(sli, func(element string) bool {
+		return strings.HasPrefix(element, "g")
+	}, "none")
+	missing := slices.FindOr(sli, func(element string) bool {
+		return strings.HasPrefix(element, "z")
+	}, "none")
+
+	fmt.Printf("found: %v, missing: %v", found, missing)
+	// Output: found: gamma, missing: none
+}
+
+func TestFindOr(t *testing.T) {
+	greaterThanTwo := func(element int) bool {
+		return element > 2
+	}
+	type args struct {
+		input        []int
+		fun          slices.FindFunc[int]
+		defaultValue int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "finds the first matching element",
+			args: args{
+				input:        []int{1, 2, 3, 4},
+				fun:          greaterThanTwo,
+				defaultValue: -1,
+			},
+			want: 3,
+		},
+		{
+			name: "provides the default when nothing matches",
+			args: args{
+				input:        []int{1, 2},
+				fun:          greaterThanTwo,
+				defaultValue: -1,
+			},
+			want: -1,
+		},
+		{
+			name: "provides the default for nil input",
+			args: args{
+				input:        nil,
+				fun:          greaterThanTwo,
+				defaultValue: -1,
+			},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FindOr(tt.args.input, tt.args.fun, tt.args.defaultValue); got != tt.want {
+				t.Errorf("FindOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFindWithIndex() {
+	records := []string{"ok", "ok", "error: disk full", "ok"}
+
+	idx, record, ok := slices.FindWithIndex(records, func(s string) bool {
+		return strings.HasPrefix(s, "error")
+	})
+
+	fmt.Printf("index: %v, record: %v, ok: %v", idx, record, ok)
+	// Output: index: 2, record: error: disk full, ok: true
+}
+
+func TestFindWithIndex(t *testing.T) {
+	type args struct {
+		input []int
+		fun   slices.FindFunc[int]
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantIndex  int
+		wantResult int
+		wantOk     bool
+	}{
+		{
+			name: "finds the first matching element",
+			args: args{
+				input: []int{1, 4, 6, 8},
+				fun: func(element int) bool {
+					return element%2 == 0
+				},
+			},
+			wantIndex:  1,
+			wantResult: 4,
+			wantOk:     true,
+		},
+		{
+			name: "no match provides -1, zero value and false",
+			args: args{
+				input: []int{1, 3, 5},
+				fun: func(element int) bool {
+					return element%2 == 0
+				},
+			},
+			wantIndex:  -1,
+			wantResult: 0,
+			wantOk:     false,
+		},
+		{
+			name: "nil input provides -1, zero value and false",
+			args: args{
+				input: nil,
+				fun: func(element int) bool {
+					return element%2 == 0
+				},
+			},
+			wantIndex:  -1,
+			wantResult: 0,
+			wantOk:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotIndex, gotResult, gotOk := slices.FindWithIndex(tt.args.input, tt.args.fun)
+			if gotIndex != tt.wantIndex {
+				t.Errorf("FindWithIndex() gotIndex = %v, want %v", gotIndex, tt.wantIndex)
+			}
+			if gotResult != tt.wantResult {
+				t.Errorf("FindWithIndex() gotResult = %v, want %v", gotResult, tt.wantResult)
+			}
+			if gotOk != tt.wantOk {
+				t.Errorf("FindWithIndex() gotOk = %v, want %v", gotOk, tt.wantOk)
+			}
+		})
+	}
+}
+
+func BenchmarkFindWithIndex(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
 		},
 	}
 	for _, bm := range benchmarks {
+		target := len(bm.sli) - 1
 		b.Run(bm.name, func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
-				_ = slices.FindLastIndex(bm.sli, bm.fn)
+				_, _, _ = slices.FindWithIndex(bm.sli, func(element int) bool {
+					return element == target
+				})
 			}
 		})
 	}
@@ -1250,6 +1833,140 @@ func BenchmarkFirst(b *testing.B) {
 	}
 }
 
+func ExampleFirstN() {
+	logLines := []string{"boot", "load", "ready", "request", "response"}
+
+	lines := slices.FirstN(logLines, 2)
+	fmt.Printf("lines: %v", lines)
+	// Output: lines: [boot load]
+}
+
+func TestFirstN(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		n     int
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "provides first n elements",
+			args: args[int]{
+				input: []int{1, 2, 3, 4, 5},
+				n:     3,
+			},
+			want: []int{1, 2, 3},
+		},
+		{
+			name: "n greater than the length provides every element",
+			args: args[int]{
+				input: []int{1, 2},
+				n:     5,
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "zero n provides nil",
+			args: args[int]{
+				input: []int{1, 2},
+				n:     0,
+			},
+			want: nil,
+		},
+		{
+			name: "negative n provides nil",
+			args: args[int]{
+				input: []int{1, 2},
+				n:     -1,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input provides nil",
+			args: args[int]{
+				input: nil,
+				n:     2,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.FirstN(tt.args.input, tt.args.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FirstN() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 {
+				got[0] = -1
+				if slices.Includes(tt.args.input, -1) {
+					t.Errorf("FirstN() shares its elements with the input")
+				}
+			}
+		})
+	}
+}
+
+func ExampleFirstOr() {
+	type config struct {
+		host string
+	}
+	candidates := []string{}
+
+	cfg := config{
+		host: slices.FirstOr(candidates, "localhost"),
+	}
+
+	fmt.Printf("host: %v", cfg.host)
+	// Output: host: localhost
+}
+
+func TestFirstOr(t *testing.T) {
+	type args struct {
+		input        []int
+		defaultValue int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "provides the first element",
+			args: args{
+				input:        []int{1, 2, 3},
+				defaultValue: -1,
+			},
+			want: 1,
+		},
+		{
+			name: "provides the default for nil input",
+			args: args{
+				input:        nil,
+				defaultValue: -1,
+			},
+			want: -1,
+		},
+		{
+			name: "provides the default for empty input",
+			args: args{
+				input:        []int{},
+				defaultValue: -1,
+			},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FirstOr(tt.args.input, tt.args.defaultValue); got != tt.want {
+				t.Errorf("FirstOr() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleGet() {
 	sli := []int{1, 2, 3, 4, 5}
 
@@ -1613,6 +2330,90 @@ func BenchmarkIndexOf(b *testing.B) {
 	}
 }
 
+func ExampleIndexOfSubslice() {
+	tokens := []string{"let", "x", "=", "1", ";", "let", "y", "=", "2"}
+
+	idx := slices.IndexOfSubslice(tokens, []string{"let", "y"})
+	fmt.Printf("index: %v", idx)
+	// Output: index: 5
+}
+
+func TestIndexOfSubslice(t *testing.T) {
+	type args struct {
+		haystack []int
+		needle   []int
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "finds the first occurrence",
+			args: args{
+				haystack: []int{1, 2, 3, 1, 2, 3},
+				needle:   []int{2, 3},
+			},
+			want: 1,
+		},
+		{
+			name: "finds an occurrence after a partial match",
+			args: args{
+				haystack: []int{1, 1, 1, 2},
+				needle:   []int{1, 1, 2},
+			},
+			want: 1,
+		},
+		{
+			name: "finds an occurrence at the end",
+			args: args{
+				haystack: []int{4, 5, 6},
+				needle:   []int{5, 6},
+			},
+			want: 1,
+		},
+		{
+			name: "missing needle provides -1",
+			args: args{
+				haystack: []int{1, 2, 3},
+				needle:   []int{3, 2},
+			},
+			want: -1,
+		},
+		{
+			name: "needle longer than the haystack provides -1",
+			args: args{
+				haystack: []int{1, 2},
+				needle:   []int{1, 2, 3},
+			},
+			want: -1,
+		},
+		{
+			name: "empty needle provides 0",
+			args: args{
+				haystack: []int{1, 2},
+				needle:   []int{},
+			},
+			want: 0,
+		},
+		{
+			name: "nil haystack provides -1",
+			args: args{
+				haystack: nil,
+				needle:   []int{1},
+			},
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.IndexOfSubslice(tt.args.haystack, tt.args.needle); got != tt.want {
+				t.Errorf("IndexOfSubslice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExampleIsEmpty() {
 	sli := []int{1, 2, 3, 4, 5}
 
@@ -1643,85 +2444,215 @@ func TestIsEmpty(t *testing.T) {
 			args: args[int]{
 				input: []int{},
 			},
-			want: true,
+			want: true,
+		},
+		{
+			name: "returns true if input is empty",
+			args: args[int]{
+				input: nil,
+			},
+			want: true,
+		},
+		{
+			name: "returns false if input has a single element",
+			args: args[int]{
+				input: []int{1},
+			},
+			want: false,
+		},
+		{
+			name: "returns false if input has multiple elements",
+			args: args[int]{
+				input: []int{1, 2},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.IsEmpty(tt.args.input); got != tt.want {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkIsEmpty(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "empty sli",
+			sli:  []int{},
+		},
+		{
+			name: "nil sli",
+			sli:  nil,
+		},
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.IsEmpty(bm.sli)
+			}
+		})
+	}
+}
+
+func ExampleLastN() {
+	logLines := []string{"boot", "load", "ready", "request", "response"}
+
+	lines := slices.LastN(logLines, 2)
+	fmt.Printf("lines: %v", lines)
+	// Output: lines: [request response]
+}
+
+func TestLastN(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		n     int
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "provides last n elements",
+			args: args[int]{
+				input: []int{1, 2, 3, 4, 5},
+				n:     3,
+			},
+			want: []int{3, 4, 5},
+		},
+		{
+			name: "n greater than the length provides every element",
+			args: args[int]{
+				input: []int{1, 2},
+				n:     5,
+			},
+			want: []int{1, 2},
 		},
 		{
-			name: "returns true if input is empty",
+			name: "zero n provides nil",
 			args: args[int]{
-				input: nil,
+				input: []int{1, 2},
+				n:     0,
 			},
-			want: true,
+			want: nil,
 		},
 		{
-			name: "returns false if input has a single element",
+			name: "negative n provides nil",
 			args: args[int]{
-				input: []int{1},
+				input: []int{1, 2},
+				n:     -1,
 			},
-			want: false,
+			want: nil,
 		},
 		{
-			name: "returns false if input has multiple elements",
+			name: "nil input provides nil",
 			args: args[int]{
-				input: []int{1, 2},
+				input: nil,
+				n:     2,
 			},
-			want: false,
+			want: nil,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := slices.IsEmpty(tt.args.input); got != tt.want {
-				t.Errorf("IsEmpty() = %v, want %v", got, tt.want)
+			got := slices.LastN(tt.args.input, tt.args.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LastN() = %v, want %v", got, tt.want)
+			}
+			if len(got) > 0 {
+				got[0] = -1
+				if slices.Includes(tt.args.input, -1) {
+					t.Errorf("LastN() shares its elements with the input")
+				}
 			}
 		})
 	}
 }
 
-func BenchmarkIsEmpty(b *testing.B) {
-	benchmarks := []struct {
+func ExampleLastOr() {
+	sli := []int{1, 2, 3}
+
+	last := slices.LastOr(sli, -1)
+	missing := slices.LastOr([]int{}, -1)
+
+	fmt.Printf("last: %v, missing: %v", last, missing)
+	// Output: last: 3, missing: -1
+}
+
+func TestLastOr(t *testing.T) {
+	type args struct {
+		input        []int
+		defaultValue int
+	}
+	tests := []struct {
 		name string
-		sli  []int
+		args args
+		want int
 	}{
 		{
-			name: "empty sli",
-			sli:  []int{},
-		},
-		{
-			name: "nil sli",
-			sli:  nil,
-		},
-		{
-			name: "3 elements",
-			sli:  []int{1, 2, 3},
-		},
-		{
-			name: "10 elements",
-			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
-		},
-		{
-			name: "100 elements",
-			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
-		},
-		{
-			name: "1_000 elements",
-			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
-		},
-		{
-			name: "10_000 elements",
-			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+			name: "provides the last element",
+			args: args{
+				input:        []int{1, 2, 3},
+				defaultValue: -1,
+			},
+			want: 3,
 		},
 		{
-			name: "100_000 elements",
-			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+			name: "provides the default for nil input",
+			args: args{
+				input:        nil,
+				defaultValue: -1,
+			},
+			want: -1,
 		},
 		{
-			name: "1_000_000 elements",
-			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+			name: "provides the default for empty input",
+			args: args{
+				input:        []int{},
+				defaultValue: -1,
+			},
+			want: -1,
 		},
 	}
-	for _, bm := range benchmarks {
-		b.Run(bm.name, func(b *testing.B) {
-			for i := 0; i < b.N; i++ {
-				_ = slices.IsEmpty(bm.sli)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.LastOr(tt.args.input, tt.args.defaultValue); got != tt.want {
+				t.Errorf("LastOr() = %v, want %v", got, tt.want)
 			}
 		})
 	}
@@ -1842,6 +2773,150 @@ func BenchmarkLength(b *testing.B) {
 	}
 }
 
+func ExampleLongestRun() {
+	statuses := []string{"up", "down", "down", "up", "down", "down", "down", "up"}
+
+	value, length, start := slices.LongestRun(statuses)
+	fmt.Printf("value: %v, length: %v, start: %v", value, length, start)
+	// Output: value: down, length: 3, start: 4
+}
+
+func TestLongestRun(t *testing.T) {
+	type args struct {
+		input []int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		wantValue      int
+		wantLength     int
+		wantStartIndex int
+	}{
+		{
+			name: "finds the longest run",
+			args: args{
+				input: []int{1, 2, 2, 3, 3, 3, 2},
+			},
+			wantValue:      3,
+			wantLength:     3,
+			wantStartIndex: 3,
+		},
+		{
+			name: "first run wins a tie",
+			args: args{
+				input: []int{5, 5, 6, 6},
+			},
+			wantValue:      5,
+			wantLength:     2,
+			wantStartIndex: 0,
+		},
+		{
+			name: "run at the end is found",
+			args: args{
+				input: []int{1, 2, 4, 4, 4},
+			},
+			wantValue:      4,
+			wantLength:     3,
+			wantStartIndex: 2,
+		},
+		{
+			name: "no repeats provides the first element",
+			args: args{
+				input: []int{7, 8, 9},
+			},
+			wantValue:      7,
+			wantLength:     1,
+			wantStartIndex: 0,
+		},
+		{
+			name: "nil input provides a start index of -1",
+			args: args{
+				input: nil,
+			},
+			wantValue:      0,
+			wantLength:     0,
+			wantStartIndex: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotValue, gotLength, gotStartIndex := slices.LongestRun(tt.args.input)
+			if gotValue != tt.wantValue {
+				t.Errorf("LongestRun() gotValue = %v, want %v", gotValue, tt.wantValue)
+			}
+			if gotLength != tt.wantLength {
+				t.Errorf("LongestRun() gotLength = %v, want %v", gotLength, tt.wantLength)
+			}
+			if gotStartIndex != tt.wantStartIndex {
+				t.Errorf("LongestRun() gotStartIndex = %v, want %v", gotStartIndex, tt.wantStartIndex)
+			}
+		})
+	}
+}
+
+func ExampleMatches() {
+	lines := []string{"starting", "ERROR: disk full", "retrying", "ERROR: timeout"}
+
+	errorLines := slices.Matches(lines, func(line string) bool {
+		return strings.HasPrefix(line, "ERROR")
+	})
+
+	for _, match := range errorLines {
+		fmt.Printf("line %v: %v\n", match.Key+1, match.Value)
+	}
+	// Output:
+	// line 2: ERROR: disk full
+	// line 4: ERROR: timeout
+}
+
+func TestMatches(t *testing.T) {
+	isEven := func(element int) bool {
+		return element%2 == 0
+	}
+	type args[T any] struct {
+		input []T
+		fun   slices.FindFunc[T]
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []maps.Entry[int, T]
+	}
+	tests := []testCase[int]{
+		{
+			name: "provides the index and value of every match",
+			args: args[int]{
+				input: []int{1, 2, 3, 4, 6},
+				fun:   isEven,
+			},
+			want: []maps.Entry[int, int]{{Key: 1, Value: 2}, {Key: 3, Value: 4}, {Key: 4, Value: 6}},
+		},
+		{
+			name: "no matches provides nil",
+			args: args[int]{
+				input: []int{1, 3},
+				fun:   isEven,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input provides nil",
+			args: args[int]{
+				input: nil,
+				fun:   isEven,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Matches(tt.args.input, tt.args.fun); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func ExamplePeekEnd() {
 	sli := []int{1, 2, 3, 4, 5}
 
@@ -2213,3 +3288,143 @@ func BenchmarkSubSlice(b *testing.B) {
 		})
 	}
 }
+
+func ExampleSubSliceStep() {
+	sli := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	everyThird := slices.SubSliceStep(sli, 0, 10, 3)
+	reversedEvens := slices.SubSliceStep(sli, 8, -1, -2)
+
+	fmt.Printf("every third: %v, reversed evens: %v", everyThird, reversedEvens)
+	// Output: every third: [0 3 6 9], reversed evens: [8 6 4 2 0]
+}
+
+func TestSubSliceStep(t *testing.T) {
+	type args struct {
+		input     []int
+		fromIndex int
+		toIndex   int
+		step      int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []int
+	}{
+		{
+			name: "takes every second element",
+			args: args{
+				input:     []int{0, 1, 2, 3, 4, 5},
+				fromIndex: 1,
+				toIndex:   6,
+				step:      2,
+			},
+			want: []int{1, 3, 5},
+		},
+		{
+			name: "step of one behaves like SubSlice",
+			args: args{
+				input:     []int{0, 1, 2, 3, 4, 5},
+				fromIndex: 2,
+				toIndex:   4,
+				step:      1,
+			},
+			want: []int{2, 3},
+		},
+		{
+			name: "negative step walks backward",
+			args: args{
+				input:     []int{0, 1, 2, 3, 4, 5},
+				fromIndex: 5,
+				toIndex:   1,
+				step:      -2,
+			},
+			want: []int{5, 3},
+		},
+		{
+			name: "out of range indexes are clamped",
+			args: args{
+				input:     []int{0, 1, 2, 3},
+				fromIndex: -5,
+				toIndex:   50,
+				step:      3,
+			},
+			want: []int{0, 3},
+		},
+		{
+			name: "out of range indexes are clamped with negative step",
+			args: args{
+				input:     []int{0, 1, 2, 3},
+				fromIndex: 50,
+				toIndex:   -50,
+				step:      -1,
+			},
+			want: []int{3, 2, 1, 0},
+		},
+		{
+			name: "backward range with positive step results in nil",
+			args: args{
+				input:     []int{0, 1, 2, 3},
+				fromIndex: 3,
+				toIndex:   1,
+				step:      1,
+			},
+			want: nil,
+		},
+		{
+			name: "zero step results in nil",
+			args: args{
+				input:     []int{0, 1, 2, 3},
+				fromIndex: 0,
+				toIndex:   4,
+				step:      0,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input results in nil",
+			args: args{
+				input:     nil,
+				fromIndex: 0,
+				toIndex:   4,
+				step:      1,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.SubSliceStep(tt.args.input, tt.args.fromIndex, tt.args.toIndex, tt.args.step)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SubSliceStep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSubSliceStep(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.SubSliceStep(bm.sli, 0, len(bm.sli), 4)
+			}
+		})
+	}
+}
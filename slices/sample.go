@@ -0,0 +1,78 @@
+package slices
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+)
+
+// WeightFunc is a function which provides the weight of an element of a slice, used to decide how likely the element
+// is to be selected.
+type WeightFunc[T any] func(T) float64
+
+// WeightedSample selects n elements from the input without replacement, where the probability of each element being
+// selected is proportional to its weight.  Elements with a weight of zero or less are never selected.  If n is at least
+// the number of eligible elements, every eligible element is returned, in a random order.  The sample is taken in a
+// single pass using the A-Res reservoir algorithm, using the provided source of randomness - if rng is nil, the
+// default source of the math/rand package is used.  If n is zero or less, or no elements are eligible, the output will
+// be nil.  Panics if the function is nil.
+func WeightedSample[T any](input []T, weight WeightFunc[T], n int, rng *rand.Rand) []T {
+	if weight == nil {
+		panic("slices.WeightedSample: weight must not be nil")
+	}
+	if n <= 0 {
+		return nil
+	}
+	random := rand.Float64
+	if rng != nil {
+		random = rng.Float64
+	}
+	reservoir := &weightedReservoir[T]{}
+	for _, element := range input {
+		w := weight(element)
+		if w <= 0 {
+			continue
+		}
+		key := math.Pow(random(), 1/w)
+		if reservoir.Len() < n {
+			heap.Push(reservoir, weightedItem[T]{element: element, key: key})
+			continue
+		}
+		if key > (*reservoir)[0].key {
+			(*reservoir)[0] = weightedItem[T]{element: element, key: key}
+			heap.Fix(reservoir, 0)
+		}
+	}
+	if reservoir.Len() == 0 {
+		return nil
+	}
+	results := make([]T, reservoir.Len())
+	for i := len(results) - 1; i >= 0; i-- {
+		results[i] = heap.Pop(reservoir).(weightedItem[T]).element
+	}
+	return results
+}
+
+// weightedItem is an element held in a weightedReservoir, along with its randomly generated key.
+type weightedItem[T any] struct {
+	element T
+	key     float64
+}
+
+// weightedReservoir is a heap.Interface of items, with the item having the smallest key at the root.
+type weightedReservoir[T any] []weightedItem[T]
+
+func (r weightedReservoir[T]) Len() int           { return len(r) }
+func (r weightedReservoir[T]) Less(i, j int) bool { return r[i].key < r[j].key }
+func (r weightedReservoir[T]) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+
+func (r *weightedReservoir[T]) Push(x any) {
+	*r = append(*r, x.(weightedItem[T]))
+}
+
+func (r *weightedReservoir[T]) Pop() any {
+	old := *r
+	last := old[len(old)-1]
+	*r = old[:len(old)-1]
+	return last
+}
@@ -0,0 +1,148 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/slices"
+	"math/rand"
+	"testing"
+)
+
+func ExampleWeightedSample() {
+	type endpoint struct {
+		name    string
+		traffic float64
+	}
+	endpoints := []endpoint{
+		{name: "/search", traffic: 0.7},
+		{name: "/login", traffic: 0.3},
+		{name: "/retired", traffic: 0},
+	}
+
+	sample := slices.WeightedSample(endpoints, func(e endpoint) float64 {
+		return e.traffic
+	}, 5, rand.New(rand.NewSource(1)))
+
+	fmt.Printf("sampled: %v", len(sample))
+	// Output: sampled: 2
+}
+
+func TestWeightedSample(t *testing.T) {
+	identity := func(element int) float64 {
+		return float64(element)
+	}
+	type args struct {
+		input  []int
+		weight slices.WeightFunc[int]
+		n      int
+	}
+	tests := []struct {
+		name       string
+		args       args
+		wantLength int
+		wantFrom   []int
+	}{
+		{
+			name: "selects n distinct eligible elements",
+			args: args{
+				input:  []int{1, 2, 3, 4, 5},
+				weight: identity,
+				n:      3,
+			},
+			wantLength: 3,
+			wantFrom:   []int{1, 2, 3, 4, 5},
+		},
+		{
+			name: "excludes elements without positive weight",
+			args: args{
+				input:  []int{-2, 0, 3, 4},
+				weight: identity,
+				n:      4,
+			},
+			wantLength: 2,
+			wantFrom:   []int{3, 4},
+		},
+		{
+			name: "zero n results in nil",
+			args: args{
+				input:  []int{1, 2, 3},
+				weight: identity,
+				n:      0,
+			},
+			wantLength: 0,
+		},
+		{
+			name: "nil input results in nil",
+			args: args{
+				input:  nil,
+				weight: identity,
+				n:      3,
+			},
+			wantLength: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.WeightedSample(tt.args.input, tt.args.weight, tt.args.n, rand.New(rand.NewSource(42)))
+			if len(got) != tt.wantLength {
+				t.Fatalf("WeightedSample() = %v, want %v elements", got, tt.wantLength)
+			}
+			if tt.wantLength == 0 && got != nil {
+				t.Errorf("WeightedSample() = %v, want nil", got)
+			}
+			if slices.CountDistinct(got) != len(got) {
+				t.Errorf("WeightedSample() = %v, want distinct elements", got)
+			}
+			for _, element := range got {
+				if !slices.Includes(tt.wantFrom, element) {
+					t.Errorf("WeightedSample() selected %v, want only elements of %v", element, tt.wantFrom)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedSample_Distribution(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	weights := map[string]float64{"heavy": 9, "light": 1}
+	counts := map[string]int{}
+	for i := 0; i < 10_000; i++ {
+		sample := slices.WeightedSample([]string{"heavy", "light"}, func(s string) float64 {
+			return weights[s]
+		}, 1, rng)
+		counts[sample[0]]++
+	}
+	ratio := float64(counts["heavy"]) / 10_000
+	if ratio < 0.87 || ratio > 0.93 {
+		t.Errorf("WeightedSample() selected the heavier element %v of the time, want around 0.9", ratio)
+	}
+}
+
+func BenchmarkWeightedSample(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.WeightedSample(bm.sli, func(element int) float64 {
+					return float64(element)
+				}, 10, rng)
+			}
+		})
+	}
+}
@@ -25,9 +25,49 @@ func DescendingSortFunc[T constraints.Ordered](a, b T) bool {
 	return a > b
 }
 
+// MergeSorted merges two slices, each already sorted in ascending order, into a new sorted slice in O(n+m) time.  If
+// either input is not sorted, the order of the output is undefined.  If one input is empty or nil, a copy of the other
+// is returned.
+func MergeSorted[T constraints.Ordered](inputA, inputB []T) []T {
+	return MergeSortedFunc(inputA, inputB, AscendingSortFunc[T])
+}
+
+// MergeSortedFunc merges two slices, each already sorted according to the provided function, into a new slice sorted
+// in the same way, in O(n+m) time.  Where elements of the two inputs are equal, those of inputA are placed first.  If
+// either input is not sorted, the order of the output is undefined.  If one input is empty or nil, a copy of the other
+// is returned.  Panics if the function is nil.
+func MergeSortedFunc[T any](inputA, inputB []T, fun SortFunc[T]) []T {
+	if fun == nil {
+		panic("slices.MergeSortedFunc: fun must not be nil")
+	}
+	if len(inputA) == 0 {
+		return Copy(inputB)
+	}
+	if len(inputB) == 0 {
+		return Copy(inputA)
+	}
+	output := make([]T, 0, len(inputA)+len(inputB))
+	i, j := 0, 0
+	for i < len(inputA) && j < len(inputB) {
+		if fun(inputB[j], inputA[i]) {
+			output = append(output, inputB[j])
+			j++
+		} else {
+			output = append(output, inputA[i])
+			i++
+		}
+	}
+	output = append(output, inputA[i:]...)
+	return append(output, inputB[j:]...)
+}
+
 // Sort orders the elements within the input slice in order, using the provided function to determine the
 // relative value of each element, and whether they should be before or after each other.
+// Panics if the function is nil.
 func Sort[T any](input []T, fun SortFunc[T]) []T {
+	if fun == nil {
+		panic("slices.Sort: fun must not be nil")
+	}
 	if len(input) == 0 {
 		return nil
 	}
@@ -44,25 +84,138 @@ func Sort[T any](input []T, fun SortFunc[T]) []T {
 // by one of the struct member fields.
 type SortFieldExtractorFunc[T any, S constraints.Ordered] func(T) S
 
+// CompareFunc is a function which compares two elements of a slice, returning a negative number when `a` should be
+// before `b`, a positive number when `a` should be after `b`, and zero when the two are considered equal.
+type CompareFunc[T any] func(a, b T) int
+
+// Asc provides a CompareFunc which orders elements in ascending order by the field extracted with the given function.
+// Panics if the function is nil.
+func Asc[T any, S constraints.Ordered](extractor SortFieldExtractorFunc[T, S]) CompareFunc[T] {
+	if extractor == nil {
+		panic("slices.Asc: extractor must not be nil")
+	}
+	return func(a, b T) int {
+		x, y := extractor(a), extractor(b)
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Desc provides a CompareFunc which orders elements in descending order by the field extracted with the given function.
+// Panics if the function is nil.
+func Desc[T any, S constraints.Ordered](extractor SortFieldExtractorFunc[T, S]) CompareFunc[T] {
+	if extractor == nil {
+		panic("slices.Desc: extractor must not be nil")
+	}
+	asc := Asc(extractor)
+	return func(a, b T) int {
+		return asc(b, a)
+	}
+}
+
+// SortByField orders the elements within the input slice by a field which is extracted from each element by the
+// extractor function, in ascending order, or descending order if descending is true.  The extractor is called once per
+// element, rather than on every comparison, so may be expensive.  Panics if the function is nil.
+func SortByField[T any, S constraints.Ordered](input []T, extractor SortFieldExtractorFunc[T, S], descending bool) []T {
+	if extractor == nil {
+		panic("slices.SortByField: extractor must not be nil")
+	}
+	if descending {
+		return SortByOrderedField(input, DescendingSortFunc[S], extractor)
+	}
+	return SortByOrderedField(input, AscendingSortFunc[S], extractor)
+}
+
+// SortByKeys orders the elements within the input slice using each of the given comparators in turn - later
+// comparators are only consulted when every earlier comparator considers two elements equal.  Elements which are equal
+// according to every comparator keep the relative order they had within the input.  Use Asc and Desc to build the
+// comparators from field extractors.  Panics if any of the comparators are nil.
+func SortByKeys[T any](input []T, comparators ...CompareFunc[T]) []T {
+	for _, comparator := range comparators {
+		if comparator == nil {
+			panic("slices.SortByKeys: comparators must not be nil")
+		}
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	inputCopy := append([]T(nil), input...)
+	sort.SliceStable(inputCopy, func(i, j int) bool {
+		a, b := inputCopy[i], inputCopy[j]
+		for _, compare := range comparators {
+			if result := compare(a, b); result != 0 {
+				return result < 0
+			}
+		}
+		return false
+	})
+	return inputCopy
+}
+
 // SortByOrderedField orders the elements within the input slice using the sort function, and using a field which is
 // extracted from each element by the extractor function. Particularly useful when trying to sort a slice of structs
-// by one of the struct member fields.
+// by one of the struct member fields.  The extractor is called once per element, rather than on every comparison.
+// Panics if either function is nil.
 func SortByOrderedField[T any, S constraints.Ordered](input []T, fun SortFunc[S], extractor SortFieldExtractorFunc[T, S]) []T {
+	if fun == nil {
+		panic("slices.SortByOrderedField: fun must not be nil")
+	}
+	if extractor == nil {
+		panic("slices.SortByOrderedField: extractor must not be nil")
+	}
+	return sortByExtractedField(input, fun, extractor, sort.Slice)
+}
+
+// SortByOrderedFieldStable orders the elements within the input slice in the same way as SortByOrderedField, except
+// that elements whose extracted fields are equal keep the relative order they had within the input.
+// Panics if either function is nil.
+func SortByOrderedFieldStable[T any, S constraints.Ordered](input []T, fun SortFunc[S], extractor SortFieldExtractorFunc[T, S]) []T {
+	if fun == nil {
+		panic("slices.SortByOrderedFieldStable: fun must not be nil")
+	}
+	if extractor == nil {
+		panic("slices.SortByOrderedFieldStable: extractor must not be nil")
+	}
+	return sortByExtractedField(input, fun, extractor, sort.SliceStable)
+}
+
+// sortByExtractedField extracts the field of each element once, then orders a copy of the input by those fields using
+// the given sorting function, such as sort.Slice or sort.SliceStable.
+func sortByExtractedField[T any, S constraints.Ordered](input []T, fun SortFunc[S], extractor SortFieldExtractorFunc[T, S], sorter func(x any, less func(i, j int) bool)) []T {
 	if len(input) == 0 {
 		return nil
 	}
-	inputCopy := append([]T(nil), input...)
-	sort.Slice(inputCopy, func(i, j int) bool {
-		a, b := extractor(inputCopy[i]), extractor(inputCopy[j])
-		return fun(a, b)
+	type keyed struct {
+		field   S
+		element T
+	}
+	keyedElements := make([]keyed, len(input))
+	for idx, element := range input {
+		keyedElements[idx] = keyed{field: extractor(element), element: element}
+	}
+	sorter(keyedElements, func(i, j int) bool {
+		return fun(keyedElements[i].field, keyedElements[j].field)
 	})
-	return inputCopy
+	output := make([]T, len(input))
+	for idx, k := range keyedElements {
+		output[idx] = k.element
+	}
+	return output
 }
 
 // SortInPlace orders the elements within the input slice in order, using the provided function to determine the
 // relative value of each element, and whether they should be before or after each other. The sort is performed on the
-// input slice, with no copy being made.
+// input slice, with no copy being made.  Panics if the function is nil.
 func SortInPlace[T any](input []T, fun SortFunc[T]) {
+	if fun == nil {
+		panic("slices.SortInPlace: fun must not be nil")
+	}
 	if len(input) == 0 {
 		return
 	}
@@ -96,3 +249,20 @@ func SortOrderedDesc[T constraints.Ordered](input []T) []T {
 func SortOrderedDescInPlace[T constraints.Ordered](input []T) {
 	SortInPlace[T](input, DescendingSortFunc[T])
 }
+
+// SortStable orders the elements within the input slice in the same way as Sort, except that elements which are equal
+// according to the provided function keep the relative order they had within the input.  Panics if the function is nil.
+func SortStable[T any](input []T, fun SortFunc[T]) []T {
+	if fun == nil {
+		panic("slices.SortStable: fun must not be nil")
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	inputCopy := append([]T(nil), input...)
+	sort.SliceStable(inputCopy, func(i, j int) bool {
+		a, b := inputCopy[i], inputCopy[j]
+		return fun(a, b)
+	})
+	return inputCopy
+}
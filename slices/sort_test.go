@@ -5,6 +5,7 @@ import (
 	"github.com/pickeringtech/go-collections/constraints"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -132,6 +133,105 @@ func TestDescendingSortFunc(t *testing.T) {
 	}
 }
 
+func ExampleMergeSorted() {
+	shardA := []int{1, 4, 9}
+	shardB := []int{2, 3, 10, 12}
+
+	merged := slices.MergeSorted(shardA, shardB)
+
+	fmt.Printf("merged: %v", merged)
+	// Output: merged: [1 2 3 4 9 10 12]
+}
+
+func TestMergeSorted(t *testing.T) {
+	type args[T constraints.Ordered] struct {
+		inputA []T
+		inputB []T
+	}
+	type testCase[T constraints.Ordered] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[int]{
+		{
+			name: "merges two sorted inputs",
+			args: args[int]{
+				inputA: []int{1, 3, 5},
+				inputB: []int{2, 4, 6, 8},
+			},
+			want: []int{1, 2, 3, 4, 5, 6, 8},
+		},
+		{
+			name: "keeps duplicate elements",
+			args: args[int]{
+				inputA: []int{1, 2, 2},
+				inputB: []int{2, 3},
+			},
+			want: []int{1, 2, 2, 2, 3},
+		},
+		{
+			name: "nil first input provides a copy of the second",
+			args: args[int]{
+				inputA: nil,
+				inputB: []int{1, 2},
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "nil second input provides a copy of the first",
+			args: args[int]{
+				inputA: []int{1, 2},
+				inputB: nil,
+			},
+			want: []int{1, 2},
+		},
+		{
+			name: "nil inputs provide nil output",
+			args: args[int]{
+				inputA: nil,
+				inputB: nil,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.MergeSorted(tt.args.inputA, tt.args.inputB)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MergeSorted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSortedFunc(t *testing.T) {
+	type record struct {
+		key    int
+		source string
+	}
+	byKeyDesc := func(a, b record) bool {
+		return a.key > b.key
+	}
+	inputA := []record{{5, "a"}, {3, "a"}, {1, "a"}}
+	inputB := []record{{4, "b"}, {3, "b"}}
+	want := []record{{5, "a"}, {4, "b"}, {3, "a"}, {3, "b"}, {1, "a"}}
+
+	got := slices.MergeSortedFunc(inputA, inputB, byKeyDesc)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeSortedFunc() = %v, want %v", got, want)
+	}
+}
+
+func TestMergeSorted_DoesNotShareInput(t *testing.T) {
+	inputA := []int{1, 2}
+	got := slices.MergeSorted(inputA, nil)
+	got[0] = 100
+	if inputA[0] != 1 {
+		t.Errorf("MergeSorted() output shares memory with the input, input is now %v", inputA)
+	}
+}
+
 func ExampleSort() {
 	sli := []int{10, 2, -1, 1000, -10, 0, 1}
 
@@ -849,6 +949,228 @@ func TestSortByOrderedField(t *testing.T) {
 	}
 }
 
+func ExampleSortByField() {
+	type release struct {
+		name    string
+		version string
+	}
+	releases := []release{{"beta", "2.0.1"}, {"stable", "1.9.0"}, {"nightly", "2.1.0"}}
+
+	newestFirst := slices.SortByField(releases, func(r release) string {
+		return r.version
+	}, true)
+
+	fmt.Printf("sorted: %v", newestFirst)
+	// Output: sorted: [{nightly 2.1.0} {beta 2.0.1} {stable 1.9.0}]
+}
+
+func TestSortByField(t *testing.T) {
+	type record struct {
+		raw string
+	}
+	tests := []struct {
+		name       string
+		input      []record
+		descending bool
+		want       []record
+	}{
+		{
+			name:       "sorts ascending",
+			input:      []record{{"30"}, {"4"}, {"200"}},
+			descending: false,
+			want:       []record{{"4"}, {"30"}, {"200"}},
+		},
+		{
+			name:       "sorts descending",
+			input:      []record{{"30"}, {"4"}, {"200"}},
+			descending: true,
+			want:       []record{{"200"}, {"30"}, {"4"}},
+		},
+		{
+			name:       "nil input provides nil output",
+			input:      nil,
+			descending: false,
+			want:       nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			got := slices.SortByField(tt.input, func(r record) int {
+				calls++
+				parsed, _ := strconv.Atoi(r.raw)
+				return parsed
+			}, tt.descending)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortByField() = %v, want %v", got, tt.want)
+			}
+			if calls != len(tt.input) {
+				t.Errorf("SortByField() called the extractor %v times, want %v", calls, len(tt.input))
+			}
+		})
+	}
+}
+
+func ExampleSortByKeys() {
+	type user struct {
+		lastName  string
+		firstName string
+		age       int
+	}
+	users := []user{
+		{"Smith", "John", 30},
+		{"Jones", "Amy", 25},
+		{"Smith", "Anna", 41},
+		{"Smith", "John", 52},
+	}
+
+	sorted := slices.SortByKeys(users,
+		slices.Asc(func(u user) string { return u.lastName }),
+		slices.Asc(func(u user) string { return u.firstName }),
+		slices.Desc(func(u user) int { return u.age }),
+	)
+
+	fmt.Printf("sorted: %v", sorted)
+	// Output: sorted: [{Jones Amy 25} {Smith Anna 41} {Smith John 52} {Smith John 30}]
+}
+
+func TestSortByKeys(t *testing.T) {
+	type record struct {
+		group    string
+		priority int
+		id       int
+	}
+	byGroup := slices.Asc(func(r record) string {
+		return r.group
+	})
+	byPriorityDesc := slices.Desc(func(r record) int {
+		return r.priority
+	})
+	type args[T any] struct {
+		input       []T
+		comparators []slices.CompareFunc[T]
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[record]{
+		{
+			name: "later comparators break ties in earlier ones",
+			args: args[record]{
+				input:       []record{{"b", 1, 1}, {"a", 1, 2}, {"b", 3, 3}, {"a", 2, 4}},
+				comparators: []slices.CompareFunc[record]{byGroup, byPriorityDesc},
+			},
+			want: []record{{"a", 2, 4}, {"a", 1, 2}, {"b", 3, 3}, {"b", 1, 1}},
+		},
+		{
+			name: "elements equal by every comparator keep their input order",
+			args: args[record]{
+				input:       []record{{"b", 1, 1}, {"a", 1, 2}, {"b", 1, 3}, {"a", 1, 4}},
+				comparators: []slices.CompareFunc[record]{byGroup, byPriorityDesc},
+			},
+			want: []record{{"a", 1, 2}, {"a", 1, 4}, {"b", 1, 1}, {"b", 1, 3}},
+		},
+		{
+			name: "no comparators keeps the input order",
+			args: args[record]{
+				input:       []record{{"b", 1, 1}, {"a", 1, 2}},
+				comparators: nil,
+			},
+			want: []record{{"b", 1, 1}, {"a", 1, 2}},
+		},
+		{
+			name: "nil input provides nil output",
+			args: args[record]{
+				input:       nil,
+				comparators: []slices.CompareFunc[record]{byGroup},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.SortByKeys(tt.args.input, tt.args.comparators...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortByKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleSortByOrderedFieldStable() {
+	type record struct {
+		date string
+		id   int
+	}
+	records := []record{{"2024-02", 1}, {"2024-01", 2}, {"2024-02", 3}, {"2024-01", 4}}
+
+	sorted := slices.SortByOrderedFieldStable(records, slices.AscendingSortFunc[string], func(r record) string {
+		return r.date
+	})
+
+	fmt.Printf("sorted: %v", sorted)
+	// Output: sorted: [{2024-01 2} {2024-01 4} {2024-02 1} {2024-02 3}]
+}
+
+func TestSortByOrderedFieldStable(t *testing.T) {
+	type record struct {
+		key int
+		id  int
+	}
+	type args[T any, S constraints.Ordered] struct {
+		input     []T
+		fun       slices.SortFunc[S]
+		extractor slices.SortFieldExtractorFunc[T, S]
+	}
+	type testCase[T any, S constraints.Ordered] struct {
+		name string
+		args args[T, S]
+		want []T
+	}
+	extractKey := func(r record) int {
+		return r.key
+	}
+	tests := []testCase[record, int]{
+		{
+			name: "equal keys keep their input order ascending",
+			args: args[record, int]{
+				input:     []record{{2, 1}, {1, 2}, {2, 3}, {1, 4}, {2, 5}},
+				fun:       slices.AscendingSortFunc[int],
+				extractor: extractKey,
+			},
+			want: []record{{1, 2}, {1, 4}, {2, 1}, {2, 3}, {2, 5}},
+		},
+		{
+			name: "equal keys keep their input order descending",
+			args: args[record, int]{
+				input:     []record{{1, 1}, {2, 2}, {1, 3}, {2, 4}},
+				fun:       slices.DescendingSortFunc[int],
+				extractor: extractKey,
+			},
+			want: []record{{2, 2}, {2, 4}, {1, 1}, {1, 3}},
+		},
+		{
+			name: "empty input provides nil output",
+			args: args[record, int]{
+				input:     []record{},
+				fun:       slices.AscendingSortFunc[int],
+				extractor: extractKey,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.SortByOrderedFieldStable(tt.args.input, tt.args.fun, tt.args.extractor)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortByOrderedFieldStable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func BenchmarkSortByOrderedField(b *testing.B) {
 	type person struct {
 		age      int
@@ -901,3 +1223,82 @@ func BenchmarkSortByOrderedField(b *testing.B) {
 		})
 	}
 }
+
+func ExampleSortStable() {
+	words := []string{"bb", "a", "cc", "d", "ee"}
+
+	sorted := slices.SortStable(words, func(a, b string) bool {
+		return len(a) < len(b)
+	})
+
+	fmt.Printf("sorted: %v, original: %v", sorted, words)
+	// Output: sorted: [a d bb cc ee], original: [bb a cc d ee]
+}
+
+func TestSortStable(t *testing.T) {
+	byLength := func(a, b string) bool {
+		return len(a) < len(b)
+	}
+	type args[T any] struct {
+		input []T
+		fun   slices.SortFunc[T]
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want []T
+	}
+	tests := []testCase[string]{
+		{
+			name: "equal elements keep their input order",
+			args: args[string]{
+				input: []string{"ccc", "b", "aa", "a", "bb", "c"},
+				fun:   byLength,
+			},
+			want: []string{"b", "a", "c", "aa", "bb", "ccc"},
+		},
+		{
+			name: "nil input provides nil output",
+			args: args[string]{
+				input: nil,
+				fun:   byLength,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.SortStable(tt.args.input, tt.args.fun)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SortStable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkSortStable(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.SortStable(bm.sli, slices.DescendingSortFunc[int])
+			}
+		})
+	}
+}
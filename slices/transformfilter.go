@@ -5,8 +5,11 @@ package slices
 type FilterFunc[T any] func(T) bool
 
 // Filter returns a new slice containing only the elements of the input slice for which the provided function returns
-// true.
+// true.  Panics if the function is nil.
 func Filter[T any](input []T, fn FilterFunc[T]) []T {
+	if fn == nil {
+		panic("slices.Filter: fn must not be nil")
+	}
 	var output []T
 	for _, element := range input {
 		if fn(element) {
@@ -15,3 +18,63 @@ func Filter[T any](input []T, fn FilterFunc[T]) []T {
 	}
 	return output
 }
+
+// FilterNot returns a new slice containing only the elements of the input slice for which the provided function returns
+// false, so that a single predicate can be used to both keep and drop elements.  Panics if the function is nil.
+func FilterNot[T any](input []T, fn FilterFunc[T]) []T {
+	if fn == nil {
+		panic("slices.FilterNot: fn must not be nil")
+	}
+	var output []T
+	for _, element := range input {
+		if !fn(element) {
+			output = append(output, element)
+		}
+	}
+	return output
+}
+
+// FilterCap behaves as Filter, but allocates the output with capacity for the expected number of matching elements
+// up front, avoiding the reallocations Filter makes as its output grows.  The hint only affects performance: if more
+// elements match, the output grows as usual, and if fewer match, the spare capacity is unused.  A hint greater than
+// the length of the input is reduced to that length, and a negative hint is treated as zero.  If no elements match,
+// the output will be nil.  Panics if the function is nil.
+func FilterCap[T any](input []T, fn FilterFunc[T], expectedMatches int) []T {
+	if fn == nil {
+		panic("slices.FilterCap: fn must not be nil")
+	}
+	if expectedMatches > len(input) {
+		expectedMatches = len(input)
+	}
+	if expectedMatches < 0 {
+		expectedMatches = 0
+	}
+	output := make([]T, 0, expectedMatches)
+	for _, element := range input {
+		if fn(element) {
+			output = append(output, element)
+		}
+	}
+	if len(output) == 0 {
+		return nil
+	}
+	return output
+}
+
+// FilterCounted returns a new slice containing only the elements of the input slice for which the provided function
+// returns true, along with the number of elements which were kept and the number which were removed.  If the input is
+// empty or nil, the output will be nil, with both counts zero.  Panics if the function is nil.
+func FilterCounted[T any](input []T, fn FilterFunc[T]) (result []T, kept int, removed int) {
+	if fn == nil {
+		panic("slices.FilterCounted: fn must not be nil")
+	}
+	for _, element := range input {
+		if fn(element) {
+			result = append(result, element)
+			kept++
+		} else {
+			removed++
+		}
+	}
+	return result, kept, removed
+}
@@ -132,3 +132,239 @@ func BenchmarkFilter(b *testing.B) {
 		})
 	}
 }
+
+func ExampleFilterNot() {
+	isExpired := func(days int) bool {
+		return days > 30
+	}
+	output := slices.FilterNot([]int{5, 45, 12, 90}, isExpired)
+	fmt.Printf("Output: %v\n", output)
+
+	// Output: Output: [5 12]
+}
+
+func TestFilterNot(t *testing.T) {
+	longerThanTwo := func(element string) bool {
+		return len(element) > 2
+	}
+	type args struct {
+		input []string
+		fun   slices.FilterFunc[string]
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "keeps elements the function rejects",
+			args: args{
+				input: []string{"a", "abc", "ab", "abcd"},
+				fun:   longerThanTwo,
+			},
+			want: []string{"a", "ab"},
+		},
+		{
+			name: "every element matching results in nil output",
+			args: args{
+				input: []string{"abc", "abcd"},
+				fun:   longerThanTwo,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input results in nil output",
+			args: args{
+				input: nil,
+				fun:   longerThanTwo,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FilterNot(tt.args.input, tt.args.fun); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterNot() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFilterCap() {
+	input := []int{1, 2, 3, 4, 5}
+	output := slices.FilterCap(input, func(element int) bool {
+		return element > 1
+	}, 4)
+	fmt.Printf("Output: %v, capacity: %v\n", output, cap(output))
+
+	// Output: Output: [2 3 4 5], capacity: 4
+}
+
+func TestFilterCap(t *testing.T) {
+	longerThanTwo := func(element string) bool {
+		return len(element) > 2
+	}
+	type args struct {
+		input           []string
+		fun             slices.FilterFunc[string]
+		expectedMatches int
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "accurate hint filters input",
+			args: args{
+				input:           []string{"a", "abc", "abcd"},
+				fun:             longerThanTwo,
+				expectedMatches: 2,
+			},
+			want: []string{"abc", "abcd"},
+		},
+		{
+			name: "low hint still includes every match",
+			args: args{
+				input:           []string{"abc", "abcd", "abcde"},
+				fun:             longerThanTwo,
+				expectedMatches: 1,
+			},
+			want: []string{"abc", "abcd", "abcde"},
+		},
+		{
+			name: "high hint still filters input",
+			args: args{
+				input:           []string{"a", "abc"},
+				fun:             longerThanTwo,
+				expectedMatches: 100,
+			},
+			want: []string{"abc"},
+		},
+		{
+			name: "negative hint still filters input",
+			args: args{
+				input:           []string{"abc", "a"},
+				fun:             longerThanTwo,
+				expectedMatches: -1,
+			},
+			want: []string{"abc"},
+		},
+		{
+			name: "no matches results in nil output",
+			args: args{
+				input:           []string{"a", "ab"},
+				fun:             longerThanTwo,
+				expectedMatches: 2,
+			},
+			want: nil,
+		},
+		{
+			name: "nil input results in nil output",
+			args: args{
+				input:           nil,
+				fun:             longerThanTwo,
+				expectedMatches: 2,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FilterCap(tt.args.input, tt.args.fun, tt.args.expectedMatches); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterCap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkFilterCap(b *testing.B) {
+	sli := slices.Generate(1_000_000, slices.NumericIdentityGenerator[int])
+	mostPass := func(element int) bool {
+		return element%5 != 0
+	}
+	b.Run("Filter", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = slices.Filter(sli, mostPass)
+		}
+	})
+	b.Run("FilterCap", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = slices.FilterCap(sli, mostPass, 800_000)
+		}
+	})
+}
+
+func ExampleFilterCounted() {
+	input := []int{1, 2, 3, 4, 5}
+	output, kept, removed := slices.FilterCounted(input, func(element int) bool {
+		return element > 2
+	})
+	fmt.Printf("Output: %v, kept: %v, removed: %v\n", output, kept, removed)
+
+	// Output: Output: [3 4 5], kept: 3, removed: 2
+}
+
+func TestFilterCounted(t *testing.T) {
+	longerThanTwo := func(element string) bool {
+		return len(element) > 2
+	}
+	type args struct {
+		input []string
+		fun   slices.FilterFunc[string]
+	}
+	tests := []struct {
+		name        string
+		args        args
+		want        []string
+		wantKept    int
+		wantRemoved int
+	}{
+		{
+			name: "filters input and counts kept and removed elements",
+			args: args{
+				input: []string{"a", "ab", "abc", "abcd"},
+				fun:   longerThanTwo,
+			},
+			want:        []string{"abc", "abcd"},
+			wantKept:    2,
+			wantRemoved: 2,
+		},
+		{
+			name: "every element removed results in nil output",
+			args: args{
+				input: []string{"a", "ab"},
+				fun:   longerThanTwo,
+			},
+			want:        nil,
+			wantKept:    0,
+			wantRemoved: 2,
+		},
+		{
+			name: "nil input results in nil output",
+			args: args{
+				input: nil,
+				fun:   longerThanTwo,
+			},
+			want:        nil,
+			wantKept:    0,
+			wantRemoved: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotKept, gotRemoved := slices.FilterCounted(tt.args.input, tt.args.fun)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FilterCounted() got = %v, want %v", got, tt.want)
+			}
+			if gotKept != tt.wantKept {
+				t.Errorf("FilterCounted() gotKept = %v, want %v", gotKept, tt.wantKept)
+			}
+			if gotRemoved != tt.wantRemoved {
+				t.Errorf("FilterCounted() gotRemoved = %v, want %v", gotRemoved, tt.wantRemoved)
+			}
+		})
+	}
+}
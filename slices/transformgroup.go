@@ -0,0 +1,102 @@
+package slices
+
+import "github.com/pickeringtech/go-collections/maps"
+
+// ChunkEvenly splits the input into exactly the given number of parts, with sizes as balanced as possible - the sizes
+// differ by at most one, with earlier parts receiving the extra elements.  When there are more parts than elements, the
+// trailing parts are empty, so that the number of parts returned always matches the number requested.  Each part is a
+// sub-slice of the input, sharing its memory.  If parts is zero or negative, the output will be nil.
+func ChunkEvenly[T any](input []T, parts int) [][]T {
+	if parts <= 0 {
+		return nil
+	}
+	size, extra := len(input)/parts, len(input)%parts
+	results := make([][]T, 0, parts)
+	start := 0
+	for i := 0; i < parts; i++ {
+		end := start + size
+		if i < extra {
+			end++
+		}
+		results = append(results, input[start:end:end])
+		start = end
+	}
+	return results
+}
+
+// KeyFunc is a function which derives a key from an element of a slice, used to decide which group the element belongs
+// to.
+type KeyFunc[T any, K comparable] func(T) K
+
+// GroupAdjacentBy groups runs of consecutive elements which share the key returned by the key function, reducing the
+// elements of each run into a single value with the provided reduction function.  Unlike GroupReduce, the order of the
+// input is respected: a key which appears again after a different key starts a new group, so the same key may appear in
+// several groups.  The accumulator of each group starts with the value returned by the initial function, which is
+// called once per group.  The groups are returned in input order, each as a maps.Entry keyed by the key of its run.
+// If the input is empty or nil, the output will be nil.  Panics if any of the functions are nil.
+func GroupAdjacentBy[T any, K comparable, A any](input []T, keyFn KeyFunc[T, K], initial func() A, fold ReductionFunc[T, A]) []maps.Entry[K, A] {
+	if keyFn == nil {
+		panic("slices.GroupAdjacentBy: keyFn must not be nil")
+	}
+	if initial == nil {
+		panic("slices.GroupAdjacentBy: initial must not be nil")
+	}
+	if fold == nil {
+		panic("slices.GroupAdjacentBy: fold must not be nil")
+	}
+	var results []maps.Entry[K, A]
+	for idx, element := range input {
+		key := keyFn(element)
+		if idx == 0 || results[len(results)-1].Key != key {
+			results = append(results, maps.Entry[K, A]{Key: key, Value: initial()})
+		}
+		last := &results[len(results)-1]
+		last.Value = fold(last.Value, element)
+	}
+	return results
+}
+
+// GroupReduce places each element of the input into a group using the key returned by the key function, and reduces
+// the elements of each group into a single value with the provided reduction function, in a single pass.  The
+// accumulator of each group starts with the value returned by the initial function, which is called once per group so
+// that groups never share a mutable starting value.  If the input is empty or nil, the output will be an empty map.
+// Panics if any of the functions are nil.
+func GroupReduce[T any, K comparable, A any](input []T, keyFn KeyFunc[T, K], initial func() A, fn ReductionFunc[T, A]) map[K]A {
+	if keyFn == nil {
+		panic("slices.GroupReduce: keyFn must not be nil")
+	}
+	if initial == nil {
+		panic("slices.GroupReduce: initial must not be nil")
+	}
+	if fn == nil {
+		panic("slices.GroupReduce: fn must not be nil")
+	}
+	results := map[K]A{}
+	for _, element := range input {
+		key := keyFn(element)
+		accum, ok := results[key]
+		if !ok {
+			accum = initial()
+		}
+		results[key] = fn(accum, element)
+	}
+	return results
+}
+
+// StridedWindows splits the input into windows of the given size, with each window starting stride elements after the
+// start of the previous one.  A stride equal to the size produces consecutive chunks, a stride of one produces every
+// sliding window, and a stride smaller than the size produces overlapping windows.  Trailing windows which would hold
+// fewer than size elements are dropped, so every window has exactly size elements.  Each window is a sub-slice of the
+// input, sharing its memory.  If size or stride is zero or negative, or size is greater than the length of the input,
+// the output will be nil.
+func StridedWindows[T any](input []T, size, stride int) [][]T {
+	if size <= 0 || stride <= 0 || size > len(input) {
+		return nil
+	}
+	results := make([][]T, 0, (len(input)-size)/stride+1)
+	for start := 0; start+size <= len(input); start += stride {
+		end := start + size
+		results = append(results, input[start:end:end])
+	}
+	return results
+}
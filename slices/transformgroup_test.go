@@ -0,0 +1,402 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleChunkEvenly() {
+	jobs := []int{1, 2, 3, 4, 5, 6, 7}
+
+	chunks := slices.ChunkEvenly(jobs, 3)
+
+	fmt.Printf("chunks: %v", chunks)
+	// Output: chunks: [[1 2 3] [4 5] [6 7]]
+}
+
+func TestChunkEvenly(t *testing.T) {
+	type args[T any] struct {
+		input []T
+		parts int
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want [][]T
+	}
+	tests := []testCase[int]{
+		{
+			name: "splits evenly when the length divides exactly",
+			args: args[int]{
+				input: []int{1, 2, 3, 4, 5, 6},
+				parts: 3,
+			},
+			want: [][]int{{1, 2}, {3, 4}, {5, 6}},
+		},
+		{
+			name: "earlier parts receive the extra elements",
+			args: args[int]{
+				input: []int{1, 2, 3, 4, 5, 6, 7, 8},
+				parts: 3,
+			},
+			want: [][]int{{1, 2, 3}, {4, 5, 6}, {7, 8}},
+		},
+		{
+			name: "more parts than elements provides empty trailing parts",
+			args: args[int]{
+				input: []int{1, 2},
+				parts: 4,
+			},
+			want: [][]int{{1}, {2}, {}, {}},
+		},
+		{
+			name: "single part holds every element",
+			args: args[int]{
+				input: []int{1, 2, 3},
+				parts: 1,
+			},
+			want: [][]int{{1, 2, 3}},
+		},
+		{
+			name: "zero parts provides nil output",
+			args: args[int]{
+				input: []int{1, 2, 3},
+				parts: 0,
+			},
+			want: nil,
+		},
+		{
+			name: "negative parts provides nil output",
+			args: args[int]{
+				input: []int{1, 2, 3},
+				parts: -1,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.ChunkEvenly(tt.args.input, tt.args.parts); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ChunkEvenly() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestChunkEvenly_AppendDoesNotOverwriteNextPart(t *testing.T) {
+	input := []int{1, 2, 3, 4}
+	chunks := slices.ChunkEvenly(input, 2)
+	_ = append(chunks[0], 99)
+	if !reflect.DeepEqual(chunks[1], []int{3, 4}) {
+		t.Errorf("ChunkEvenly() second part = %v after appending to the first, want [3 4]", chunks[1])
+	}
+}
+
+func ExampleGroupAdjacentBy() {
+	type event struct {
+		Status  string
+		Seconds int
+	}
+	log := []event{
+		{Status: "up", Seconds: 30},
+		{Status: "up", Seconds: 20},
+		{Status: "down", Seconds: 5},
+		{Status: "up", Seconds: 40},
+	}
+
+	segments := slices.GroupAdjacentBy(log, func(e event) string {
+		return e.Status
+	}, func() int {
+		return 0
+	}, func(total int, e event) int {
+		return total + e.Seconds
+	})
+
+	for _, segment := range segments {
+		fmt.Printf("%v for %vs\n", segment.Key, segment.Value)
+	}
+	// Output:
+	// up for 50s
+	// down for 5s
+	// up for 40s
+}
+
+func TestGroupAdjacentBy(t *testing.T) {
+	parity := func(element int) bool {
+		return element%2 == 0
+	}
+	collect := func(accum []int, element int) []int {
+		return append(accum, element)
+	}
+	newGroup := func() []int {
+		return nil
+	}
+	tests := []struct {
+		name  string
+		input []int
+		want  []maps.Entry[bool, []int]
+	}{
+		{
+			name:  "groups consecutive elements sharing a key",
+			input: []int{2, 4, 1, 3, 6},
+			want: []maps.Entry[bool, []int]{
+				{Key: true, Value: []int{2, 4}},
+				{Key: false, Value: []int{1, 3}},
+				{Key: true, Value: []int{6}},
+			},
+		},
+		{
+			name:  "single run provides one group",
+			input: []int{1, 3, 5},
+			want: []maps.Entry[bool, []int]{
+				{Key: false, Value: []int{1, 3, 5}},
+			},
+		},
+		{
+			name:  "alternating keys provide a group per element",
+			input: []int{1, 2, 3},
+			want: []maps.Entry[bool, []int]{
+				{Key: false, Value: []int{1}},
+				{Key: true, Value: []int{2}},
+				{Key: false, Value: []int{3}},
+			},
+		},
+		{
+			name:  "nil input provides nil",
+			input: nil,
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.GroupAdjacentBy(tt.input, parity, newGroup, collect)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupAdjacentBy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleGroupReduce() {
+	type order struct {
+		customer string
+		total    int
+	}
+	orders := []order{
+		{customer: "alice", total: 10},
+		{customer: "bob", total: 5},
+		{customer: "alice", total: 7},
+	}
+
+	totals := slices.GroupReduce(orders, func(o order) string {
+		return o.customer
+	}, func() int {
+		return 0
+	}, func(accum int, o order) int {
+		return accum + o.total
+	})
+
+	fmt.Printf("totals: %v", totals)
+	// Output: totals: map[alice:17 bob:5]
+}
+
+func TestGroupReduce(t *testing.T) {
+	type args[T any, K comparable, A any] struct {
+		input   []T
+		keyFn   slices.KeyFunc[T, K]
+		initial func() A
+		fn      slices.ReductionFunc[T, A]
+	}
+	type testCase[T any, K comparable, A any] struct {
+		name string
+		args args[T, K, A]
+		want map[K]A
+	}
+	isEven := func(element int) bool {
+		return element%2 == 0
+	}
+	collect := func(accum []int, element int) []int {
+		return append(accum, element)
+	}
+	tests := []testCase[int, bool, []int]{
+		{
+			name: "groups and reduces elements by key",
+			args: args[int, bool, []int]{
+				input: []int{1, 2, 3, 4, 5},
+				keyFn: isEven,
+				initial: func() []int {
+					return []int{}
+				},
+				fn: collect,
+			},
+			want: map[bool][]int{
+				false: {1, 3, 5},
+				true:  {2, 4},
+			},
+		},
+		{
+			name: "each group starts from its own initial value",
+			args: args[int, bool, []int]{
+				input: []int{1, 2},
+				keyFn: isEven,
+				initial: func() []int {
+					return make([]int, 0, 10)
+				},
+				fn: collect,
+			},
+			want: map[bool][]int{
+				false: {1},
+				true:  {2},
+			},
+		},
+		{
+			name: "nil input provides empty output",
+			args: args[int, bool, []int]{
+				input: nil,
+				keyFn: isEven,
+				initial: func() []int {
+					return []int{}
+				},
+				fn: collect,
+			},
+			want: map[bool][]int{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.GroupReduce(tt.args.input, tt.args.keyFn, tt.args.initial, tt.args.fn)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GroupReduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkGroupReduce(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "10 elements",
+			sli:  slices.Generate(10, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000 elements",
+			sli:  slices.Generate(1_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "100_000 elements",
+			sli:  slices.Generate(100_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = slices.GroupReduce(bm.sli, func(element int) int {
+					return element % 10
+				}, func() int {
+					return 0
+				}, slices.TotalReducer[int])
+			}
+		})
+	}
+}
+
+func ExampleStridedWindows() {
+	samples := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	// Frames of 4 samples with a 50% hop.
+	frames := slices.StridedWindows(samples, 4, 2)
+
+	fmt.Printf("frames: %v", frames)
+	// Output: frames: [[1 2 3 4] [3 4 5 6] [5 6 7 8]]
+}
+
+func TestStridedWindows(t *testing.T) {
+	type args[T any] struct {
+		input  []T
+		size   int
+		stride int
+	}
+	type testCase[T any] struct {
+		name string
+		args args[T]
+		want [][]T
+	}
+	tests := []testCase[int]{
+		{
+			name: "stride equal to size produces chunks",
+			args: args[int]{
+				input:  []int{1, 2, 3, 4, 5, 6},
+				size:   2,
+				stride: 2,
+			},
+			want: [][]int{{1, 2}, {3, 4}, {5, 6}},
+		},
+		{
+			name: "stride of one produces sliding windows",
+			args: args[int]{
+				input:  []int{1, 2, 3, 4},
+				size:   3,
+				stride: 1,
+			},
+			want: [][]int{{1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			name: "stride larger than size skips elements",
+			args: args[int]{
+				input:  []int{1, 2, 3, 4, 5, 6, 7},
+				size:   2,
+				stride: 3,
+			},
+			want: [][]int{{1, 2}, {4, 5}},
+		},
+		{
+			name: "trailing partial window is dropped",
+			args: args[int]{
+				input:  []int{1, 2, 3, 4, 5},
+				size:   2,
+				stride: 2,
+			},
+			want: [][]int{{1, 2}, {3, 4}},
+		},
+		{
+			name: "size larger than the input provides nil output",
+			args: args[int]{
+				input:  []int{1, 2},
+				size:   3,
+				stride: 1,
+			},
+			want: nil,
+		},
+		{
+			name: "zero size provides nil output",
+			args: args[int]{
+				input:  []int{1, 2},
+				size:   0,
+				stride: 1,
+			},
+			want: nil,
+		},
+		{
+			name: "zero stride provides nil output",
+			args: args[int]{
+				input:  []int{1, 2},
+				size:   1,
+				stride: 0,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.StridedWindows(tt.args.input, tt.args.size, tt.args.stride); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StridedWindows() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
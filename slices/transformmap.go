@@ -1,15 +1,48 @@
 package slices
 
+import "context"
+
 // MapFunc is a function which can be used to map a slice. It receives an element from the slice and returns the result
 // of the mapping function.  The result of the mapping function is placed into the resulting slice.
 type MapFunc[I, O any] func(I) O
 
 // Map iterates over each element of the input, applying the provided mapping function, producing a new slice with the
-// outputs of the mapping function.  If the input is empty or nil, the output will be nil.
+// outputs of the mapping function.  If the input is empty or nil, the output will be nil.  Panics if the mapping
+// function is nil.
 func Map[I, O any](input []I, fun MapFunc[I, O]) []O {
+	if fun == nil {
+		panic("slices.Map: fun must not be nil")
+	}
 	var output []O
 	for _, element := range input {
 		output = append(output, fun(element))
 	}
 	return output
 }
+
+// MapContextFunc is a mapping function which receives a context, allowing cancellation to be passed on to any calls it
+// makes, and which may fail with an error.
+type MapContextFunc[I, O any] func(ctx context.Context, element I) (O, error)
+
+// MapContext iterates over each element of the input, applying the provided mapping function, producing a new slice
+// with the outputs of the mapping function.  The context is checked before each element - once it is cancelled, the
+// results mapped so far are returned along with the context's error.  If the mapping function returns an error, the
+// results mapped before the failing element are returned along with that error.  If the input is empty or nil, the
+// output will be nil.  Panics if the mapping function is nil.
+func MapContext[I, O any](ctx context.Context, input []I, fun MapContextFunc[I, O]) ([]O, error) {
+	if fun == nil {
+		panic("slices.MapContext: fun must not be nil")
+	}
+	var output []O
+	for _, element := range input {
+		if err := ctx.Err(); err != nil {
+			return output, err
+		}
+		result, err := fun(ctx, element)
+		if err != nil {
+			return output, err
+		}
+		output = append(output, result)
+	}
+	return output, nil
+}
@@ -1,9 +1,12 @@
 package slices_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strconv"
 	"testing"
 )
 
@@ -145,3 +148,409 @@ func BenchmarkMap(b *testing.B) {
 		})
 	}
 }
+
+func TestNilFunctionPanics(t *testing.T) {
+	input := []int{1, 2, 3}
+	identity := func(element int) int { return element }
+	zero := func() int { return 0 }
+	tests := []struct {
+		name      string
+		call      func()
+		wantPanic string
+	}{
+		{
+			name:      "Map",
+			call:      func() { slices.Map[int, int](input, nil) },
+			wantPanic: "slices.Map: fun must not be nil",
+		},
+		{
+			name:      "Filter",
+			call:      func() { slices.Filter(input, nil) },
+			wantPanic: "slices.Filter: fn must not be nil",
+		},
+		{
+			name:      "FilterNot",
+			call:      func() { slices.FilterNot(input, nil) },
+			wantPanic: "slices.FilterNot: fn must not be nil",
+		},
+		{
+			name:      "FilterCap",
+			call:      func() { slices.FilterCap(input, nil, 1) },
+			wantPanic: "slices.FilterCap: fn must not be nil",
+		},
+		{
+			name:      "FilterCounted",
+			call:      func() { slices.FilterCounted(input, nil) },
+			wantPanic: "slices.FilterCounted: fn must not be nil",
+		},
+		{
+			name:      "Reduce",
+			call:      func() { slices.Reduce[int, int](input, nil) },
+			wantPanic: "slices.Reduce: fn must not be nil",
+		},
+		{
+			name:      "ReduceUntil",
+			call:      func() { slices.ReduceUntil[int, int](input, 0, nil) },
+			wantPanic: "slices.ReduceUntil: fn must not be nil",
+		},
+		{
+			name:      "MapReduce with nil map function",
+			call:      func() { slices.MapReduce[int, int, int](input, nil, 0, slices.TotalReducer[int]) },
+			wantPanic: "slices.MapReduce: mapFn must not be nil",
+		},
+		{
+			name: "MapReduce with nil reduce function",
+			call: func() {
+				slices.MapReduce[int, int, int](input, func(element int) int { return element }, 0, nil)
+			},
+			wantPanic: "slices.MapReduce: reduceFn must not be nil",
+		},
+		{
+			name: "FilterMapReduce with nil filter function",
+			call: func() {
+				slices.FilterMapReduce[int, int, int](input, nil, func(element int) int { return element }, 0, slices.TotalReducer[int])
+			},
+			wantPanic: "slices.FilterMapReduce: filterFn must not be nil",
+		},
+		{
+			name:      "ScanIndexed",
+			call:      func() { slices.ScanIndexed[int, int](input, 0, nil) },
+			wantPanic: "slices.ScanIndexed: fn must not be nil",
+		},
+		{
+			name:      "FoldMap with nil map function",
+			call:      func() { slices.FoldMap[int, int](input, nil, slices.TotalReducer[int], 0) },
+			wantPanic: "slices.FoldMap: mapFn must not be nil",
+		},
+		{
+			name: "FoldMap with nil combine function",
+			call: func() {
+				slices.FoldMap(input, func(element int) int { return element }, nil, 0)
+			},
+			wantPanic: "slices.FoldMap: combine must not be nil",
+		},
+		{
+			name:      "MapContext",
+			call:      func() { slices.MapContext[int, int](context.Background(), input, nil) },
+			wantPanic: "slices.MapContext: fun must not be nil",
+		},
+		{
+			name:      "EachWindow",
+			call:      func() { slices.EachWindow[int](input, 2, nil) },
+			wantPanic: "slices.EachWindow: fn must not be nil",
+		},
+		{
+			name:      "ForEachReverse",
+			call:      func() { slices.ForEachReverse[int](input, nil) },
+			wantPanic: "slices.ForEachReverse: fn must not be nil",
+		},
+		{
+			name:      "ForEachReverseWithIndex",
+			call:      func() { slices.ForEachReverseWithIndex[int](input, nil) },
+			wantPanic: "slices.ForEachReverseWithIndex: fn must not be nil",
+		},
+		{
+			name:      "BuildString",
+			call:      func() { slices.BuildString[int](input, nil) },
+			wantPanic: "slices.BuildString: fn must not be nil",
+		},
+		{
+			name:      "ReplaceFunc",
+			call:      func() { slices.ReplaceFunc[int](input, nil) },
+			wantPanic: "slices.ReplaceFunc: fn must not be nil",
+		},
+		{
+			name:      "CoalesceFunc",
+			call:      func() { slices.CoalesceFunc[int](nil, 1, 2) },
+			wantPanic: "slices.CoalesceFunc: isEmpty must not be nil",
+		},
+		{
+			name:      "FindOr",
+			call:      func() { slices.FindOr[int](input, nil, 0) },
+			wantPanic: "slices.FindOr: fun must not be nil",
+		},
+		{
+			name:      "FindWithIndex",
+			call:      func() { slices.FindWithIndex[int](input, nil) },
+			wantPanic: "slices.FindWithIndex: fun must not be nil",
+		},
+		{
+			name:      "Matches",
+			call:      func() { slices.Matches[int](input, nil) },
+			wantPanic: "slices.Matches: fun must not be nil",
+		},
+		{
+			name:      "WeightedSample",
+			call:      func() { slices.WeightedSample[int](input, nil, 1, nil) },
+			wantPanic: "slices.WeightedSample: weight must not be nil",
+		},
+		{
+			name:      "MergeSortedFunc",
+			call:      func() { slices.MergeSortedFunc[int](input, input, nil) },
+			wantPanic: "slices.MergeSortedFunc: fun must not be nil",
+		},
+		{
+			name:      "Asc",
+			call:      func() { slices.Asc[int, int](nil) },
+			wantPanic: "slices.Asc: extractor must not be nil",
+		},
+		{
+			name:      "Desc",
+			call:      func() { slices.Desc[int, int](nil) },
+			wantPanic: "slices.Desc: extractor must not be nil",
+		},
+		{
+			name:      "SortByField",
+			call:      func() { slices.SortByField[int, int](input, nil, false) },
+			wantPanic: "slices.SortByField: extractor must not be nil",
+		},
+		{
+			name:      "SortByKeys with a nil comparator",
+			call:      func() { slices.SortByKeys(input, slices.Asc(identity), nil) },
+			wantPanic: "slices.SortByKeys: comparators must not be nil",
+		},
+		{
+			name:      "SortByOrderedFieldStable with nil sort function",
+			call:      func() { slices.SortByOrderedFieldStable[int, int](input, nil, identity) },
+			wantPanic: "slices.SortByOrderedFieldStable: fun must not be nil",
+		},
+		{
+			name:      "SortByOrderedFieldStable with nil extractor",
+			call:      func() { slices.SortByOrderedFieldStable[int, int](input, slices.AscendingSortFunc[int], nil) },
+			wantPanic: "slices.SortByOrderedFieldStable: extractor must not be nil",
+		},
+		{
+			name:      "SortStable",
+			call:      func() { slices.SortStable[int](input, nil) },
+			wantPanic: "slices.SortStable: fun must not be nil",
+		},
+		{
+			name:      "GroupAdjacentBy with nil key function",
+			call:      func() { slices.GroupAdjacentBy[int, int, int](input, nil, zero, slices.TotalReducer[int]) },
+			wantPanic: "slices.GroupAdjacentBy: keyFn must not be nil",
+		},
+		{
+			name:      "GroupAdjacentBy with nil initial function",
+			call:      func() { slices.GroupAdjacentBy[int, int, int](input, identity, nil, slices.TotalReducer[int]) },
+			wantPanic: "slices.GroupAdjacentBy: initial must not be nil",
+		},
+		{
+			name:      "GroupAdjacentBy with nil fold function",
+			call:      func() { slices.GroupAdjacentBy[int, int, int](input, identity, zero, nil) },
+			wantPanic: "slices.GroupAdjacentBy: fold must not be nil",
+		},
+		{
+			name:      "GroupReduce with nil key function",
+			call:      func() { slices.GroupReduce[int, int, int](input, nil, zero, slices.TotalReducer[int]) },
+			wantPanic: "slices.GroupReduce: keyFn must not be nil",
+		},
+		{
+			name:      "GroupReduce with nil initial function",
+			call:      func() { slices.GroupReduce[int, int, int](input, identity, nil, slices.TotalReducer[int]) },
+			wantPanic: "slices.GroupReduce: initial must not be nil",
+		},
+		{
+			name:      "GroupReduce with nil reduce function",
+			call:      func() { slices.GroupReduce[int, int, int](input, identity, zero, nil) },
+			wantPanic: "slices.GroupReduce: fn must not be nil",
+		},
+		{
+			name:      "Collectify with nil supplier",
+			call:      func() { slices.Collectify[int, int, int](input, nil, func(accumulator int, element int) {}, identity) },
+			wantPanic: "slices.Collectify: supplier must not be nil",
+		},
+		{
+			name:      "Collectify with nil accumulate function",
+			call:      func() { slices.Collectify[int, int, int](input, zero, nil, identity) },
+			wantPanic: "slices.Collectify: accumulate must not be nil",
+		},
+		{
+			name:      "Collectify with nil finisher",
+			call:      func() { slices.Collectify[int, int, int](input, zero, func(accumulator int, element int) {}, nil) },
+			wantPanic: "slices.Collectify: finisher must not be nil",
+		},
+		{
+			name:      "ParallelFoldOrdered with nil fold function",
+			call:      func() { slices.ParallelFoldOrdered[int, int](input, 2, nil, slices.TotalReducer[int]) },
+			wantPanic: "slices.ParallelFoldOrdered: foldChunk must not be nil",
+		},
+		{
+			name:      "ParallelFoldOrdered with nil combine function",
+			call:      func() { slices.ParallelFoldOrdered[int, int](input, 2, slices.Sum[int], nil) },
+			wantPanic: "slices.ParallelFoldOrdered: combine must not be nil",
+		},
+		{
+			name:      "Tee with nil first function",
+			call:      func() { slices.Tee[int, int, int](input, nil, slices.Sum[int]) },
+			wantPanic: "slices.Tee: fnA must not be nil",
+		},
+		{
+			name:      "Tee with nil second function",
+			call:      func() { slices.Tee[int, int, int](input, slices.Sum[int], nil) },
+			wantPanic: "slices.Tee: fnB must not be nil",
+		},
+		{
+			name:      "AllMatch",
+			call:      func() { slices.AllMatch[int](input, nil) },
+			wantPanic: "slices.AllMatch: fun must not be nil",
+		},
+		{
+			name:      "AnyMatch",
+			call:      func() { slices.AnyMatch[int](input, nil) },
+			wantPanic: "slices.AnyMatch: fun must not be nil",
+		},
+		{
+			name:      "Find",
+			call:      func() { slices.Find[int](input, nil) },
+			wantPanic: "slices.Find: fun must not be nil",
+		},
+		{
+			name:      "FindIndex",
+			call:      func() { slices.FindIndex[int](input, nil) },
+			wantPanic: "slices.FindIndex: fun must not be nil",
+		},
+		{
+			name:      "FindLast",
+			call:      func() { slices.FindLast[int](input, nil) },
+			wantPanic: "slices.FindLast: fun must not be nil",
+		},
+		{
+			name:      "FindLastIndex",
+			call:      func() { slices.FindLastIndex[int](input, nil) },
+			wantPanic: "slices.FindLastIndex: fun must not be nil",
+		},
+		{
+			name:      "Sort",
+			call:      func() { slices.Sort[int](input, nil) },
+			wantPanic: "slices.Sort: fun must not be nil",
+		},
+		{
+			name:      "SortByOrderedField with nil sort function",
+			call:      func() { slices.SortByOrderedField[int, int](input, nil, identity) },
+			wantPanic: "slices.SortByOrderedField: fun must not be nil",
+		},
+		{
+			name:      "SortByOrderedField with nil extractor",
+			call:      func() { slices.SortByOrderedField[int, int](input, slices.AscendingSortFunc[int], nil) },
+			wantPanic: "slices.SortByOrderedField: extractor must not be nil",
+		},
+		{
+			name:      "SortInPlace",
+			call:      func() { slices.SortInPlace[int](input, nil) },
+			wantPanic: "slices.SortInPlace: fun must not be nil",
+		},
+		{
+			name:      "Map with empty input",
+			call:      func() { slices.Map[int, int](nil, nil) },
+			wantPanic: "slices.Map: fun must not be nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if got := recover(); got != tt.wantPanic {
+					t.Errorf("%v panicked with %v, want %v", tt.name, got, tt.wantPanic)
+				}
+			}()
+			tt.call()
+		})
+	}
+}
+
+func ExampleMapContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ids := []int{1, 2, 3, 4}
+	names, err := slices.MapContext(ctx, ids, func(ctx context.Context, id int) (string, error) {
+		if id == 2 {
+			// Simulate the client disconnecting part way through.
+			cancel()
+		}
+		return "user-" + strconv.Itoa(id), nil
+	})
+
+	fmt.Printf("names: %v, err: %v", names, err)
+	// Output: names: [user-1 user-2], err: context canceled
+}
+
+func TestMapContext(t *testing.T) {
+	errFailed := errors.New("failed")
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	type args[I any, O any] struct {
+		ctx   context.Context
+		input []I
+		fun   slices.MapContextFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name    string
+		args    args[I, O]
+		want    []O
+		wantErr error
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "maps every element",
+			args: args[int, int]{
+				ctx:   context.Background(),
+				input: []int{1, 2, 3},
+				fun: func(ctx context.Context, element int) (int, error) {
+					return element * 10, nil
+				},
+			},
+			want:    []int{10, 20, 30},
+			wantErr: nil,
+		},
+		{
+			name: "stops at the first error, returning the partial result",
+			args: args[int, int]{
+				ctx:   context.Background(),
+				input: []int{1, 2, 3},
+				fun: func(ctx context.Context, element int) (int, error) {
+					if element == 3 {
+						return 0, errFailed
+					}
+					return element * 10, nil
+				},
+			},
+			want:    []int{10, 20},
+			wantErr: errFailed,
+		},
+		{
+			name: "cancelled context maps nothing",
+			args: args[int, int]{
+				ctx:   cancelled,
+				input: []int{1, 2, 3},
+				fun: func(ctx context.Context, element int) (int, error) {
+					return element * 10, nil
+				},
+			},
+			want:    nil,
+			wantErr: context.Canceled,
+		},
+		{
+			name: "nil input provides nil output",
+			args: args[int, int]{
+				ctx:   context.Background(),
+				input: nil,
+				fun: func(ctx context.Context, element int) (int, error) {
+					return element * 10, nil
+				},
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slices.MapContext(tt.args.ctx, tt.args.input, tt.args.fun)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapContext() got = %v, want %v", got, tt.want)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("MapContext() err = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
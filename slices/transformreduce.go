@@ -1,6 +1,9 @@
 package slices
 
-import "github.com/pickeringtech/go-collections/constraints"
+import (
+	"github.com/pickeringtech/go-collections/constraints"
+	"sync"
+)
 
 // ReductionFunc is a function that can be used to reduce a slice of values to a single value.
 type ReductionFunc[I, O any] func(accum O, currVal I) O
@@ -25,11 +28,199 @@ func NewCountOccurrencesReducer[I comparable, O constraints.Numeric](toCount []I
 }
 
 // Reduce iterates over each element of the input, applying the provided reduction function, producing a single value
-// which is the result of the reduction function.  If the input is empty or nil, the output will be nil.
+// which is the result of the reduction function.  If the input is empty or nil, the output will be nil.  Panics if the
+// reduction function is nil.
 func Reduce[I, O any](input []I, fn ReductionFunc[I, O]) O {
+	if fn == nil {
+		panic("slices.Reduce: fn must not be nil")
+	}
 	var accumulator O
 	for _, el := range input {
 		accumulator = fn(accumulator, el)
 	}
 	return accumulator
 }
+
+// Collectify aggregates the input using a mutable accumulator, in three steps: the supplier function creates the
+// accumulator, the accumulate function is called with the accumulator and each element in turn, updating the
+// accumulator in place, and finally the finisher function transforms the accumulator into the result.  This suits
+// accumulators such as structs or builders which are updated rather than replaced.  If the input is empty or nil, the
+// result of finishing a freshly supplied accumulator is returned.  Panics if any of the functions are nil.
+func Collectify[T, A, R any](input []T, supplier func() A, accumulate func(accumulator A, element T), finisher func(accumulator A) R) R {
+	if supplier == nil {
+		panic("slices.Collectify: supplier must not be nil")
+	}
+	if accumulate == nil {
+		panic("slices.Collectify: accumulate must not be nil")
+	}
+	if finisher == nil {
+		panic("slices.Collectify: finisher must not be nil")
+	}
+	accumulator := supplier()
+	for _, el := range input {
+		accumulate(accumulator, el)
+	}
+	return finisher(accumulator)
+}
+
+// CombineFunc is a function which combines two accumulated values into one.
+type CombineFunc[A any] func(a, b A) A
+
+// FoldMap transforms each element of the input into the accumulator type using the map function, then combines all of
+// the transformed values, in order, using the combine function, starting with the identity value.  The combine function
+// is expected to treat the identity value as a no-op, such as an empty string for concatenation.  If the input is empty
+// or nil, the identity value is returned.  Panics if either function is nil.
+func FoldMap[T, A any](input []T, mapFn MapFunc[T, A], combine CombineFunc[A], identity A) A {
+	if mapFn == nil {
+		panic("slices.FoldMap: mapFn must not be nil")
+	}
+	if combine == nil {
+		panic("slices.FoldMap: combine must not be nil")
+	}
+	accumulator := identity
+	for _, el := range input {
+		accumulator = combine(accumulator, mapFn(el))
+	}
+	return accumulator
+}
+
+// MapReduce transforms each element of the input using the map function and folds the transformed value straight into
+// the accumulator using the reduction function, starting with the initial value.  As the map and reduce happen in a
+// single pass, no intermediate slice is allocated, unlike calling Map followed by Reduce.  If the input is empty or
+// nil, the initial value is returned.  Panics if either function is nil.
+func MapReduce[T, M, A any](input []T, mapFn MapFunc[T, M], initial A, reduceFn ReductionFunc[M, A]) A {
+	if mapFn == nil {
+		panic("slices.MapReduce: mapFn must not be nil")
+	}
+	if reduceFn == nil {
+		panic("slices.MapReduce: reduceFn must not be nil")
+	}
+	accumulator := initial
+	for _, el := range input {
+		accumulator = reduceFn(accumulator, mapFn(el))
+	}
+	return accumulator
+}
+
+// FilterMapReduce behaves as MapReduce, but only includes the elements of the input for which the filter function
+// returns true, all within a single pass.  If the input is empty or nil, or no element passes the filter, the initial
+// value is returned.  Panics if any of the functions are nil.
+func FilterMapReduce[T, M, A any](input []T, filterFn FilterFunc[T], mapFn MapFunc[T, M], initial A, reduceFn ReductionFunc[M, A]) A {
+	if filterFn == nil {
+		panic("slices.FilterMapReduce: filterFn must not be nil")
+	}
+	if mapFn == nil {
+		panic("slices.FilterMapReduce: mapFn must not be nil")
+	}
+	if reduceFn == nil {
+		panic("slices.FilterMapReduce: reduceFn must not be nil")
+	}
+	accumulator := initial
+	for _, el := range input {
+		if filterFn(el) {
+			accumulator = reduceFn(accumulator, mapFn(el))
+		}
+	}
+	return accumulator
+}
+
+// ReductionUntilFunc is a reduction function which also reports whether the reduction should continue on to the next
+// element.
+type ReductionUntilFunc[I, O any] func(accum O, currVal I) (O, bool)
+
+// ReduceUntil iterates over each element of the input, starting with the initial value as the accumulator and applying
+// the provided reduction function, until the function reports that it should not continue.  The accumulator returned
+// for the final element processed is kept.  Along with the result, the index of the first element which was not
+// processed is returned - this is the length of the input if every element was processed - so that the reduction can
+// later be resumed from that index.  If the input is empty or nil, the initial value and zero are returned.  Panics if
+// the reduction function is nil.
+func ReduceUntil[I, O any](input []I, initial O, fn ReductionUntilFunc[I, O]) (result O, stoppedAt int) {
+	if fn == nil {
+		panic("slices.ReduceUntil: fn must not be nil")
+	}
+	result = initial
+	for idx, el := range input {
+		var next bool
+		result, next = fn(result, el)
+		if !next {
+			return result, idx + 1
+		}
+	}
+	return result, len(input)
+}
+
+// IndexedReductionFunc is a reduction function which also receives the index of the current element.
+type IndexedReductionFunc[I, O any] func(accum O, index int, currVal I) O
+
+// ScanIndexed iterates over each element of the input, starting with the initial value as the accumulator and applying
+// the provided reduction function along with the index of each element, returning every intermediate accumulator.  The
+// result holds one accumulator per element - the initial value itself is not included.  If the input is empty or nil,
+// the output will be nil.  Panics if the reduction function is nil.
+func ScanIndexed[I, O any](input []I, initial O, fn IndexedReductionFunc[I, O]) []O {
+	if fn == nil {
+		panic("slices.ScanIndexed: fn must not be nil")
+	}
+	if len(input) == 0 {
+		return nil
+	}
+	results := make([]O, len(input))
+	accumulator := initial
+	for idx, el := range input {
+		accumulator = fn(accumulator, idx, el)
+		results[idx] = accumulator
+	}
+	return results
+}
+
+// ParallelFoldOrdered splits the input into as many contiguous chunks as there are workers, folds each chunk into a
+// partial result concurrently using the foldChunk function, then combines the partial results from left to right in
+// the order of the chunks.  As the combine order always follows the input order, the combine function need not be
+// commutative, only associative.  A workers value of zero or less is treated as one, and no more workers are used than
+// there are elements.  If the input is empty or nil, the result of folding an empty chunk is returned.
+// Panics if either function is nil.
+func ParallelFoldOrdered[T, A any](input []T, workers int, foldChunk func([]T) A, combine CombineFunc[A]) A {
+	if foldChunk == nil {
+		panic("slices.ParallelFoldOrdered: foldChunk must not be nil")
+	}
+	if combine == nil {
+		panic("slices.ParallelFoldOrdered: combine must not be nil")
+	}
+	if len(input) == 0 {
+		return foldChunk(nil)
+	}
+	if workers <= 0 {
+		workers = 1
+	}
+	if workers > len(input) {
+		workers = len(input)
+	}
+	chunks := ChunkEvenly(input, workers)
+	partials := make([]A, len(chunks))
+	var wg sync.WaitGroup
+	wg.Add(len(chunks))
+	for idx, chunk := range chunks {
+		go func(idx int, chunk []T) {
+			defer wg.Done()
+			partials[idx] = foldChunk(chunk)
+		}(idx, chunk)
+	}
+	wg.Wait()
+	result := partials[0]
+	for _, partial := range partials[1:] {
+		result = combine(result, partial)
+	}
+	return result
+}
+
+// Tee applies two independent whole-slice computations to the same input, returning both results.  The functions are
+// called in order, each receiving the input as is - neither should modify it if the other relies on its contents.
+// Panics if either function is nil.
+func Tee[T, A, B any](input []T, fnA func([]T) A, fnB func([]T) B) (A, B) {
+	if fnA == nil {
+		panic("slices.Tee: fnA must not be nil")
+	}
+	if fnB == nil {
+		panic("slices.Tee: fnB must not be nil")
+	}
+	return fnA(input), fnB(input)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/pickeringtech/go-collections/slices"
 	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -214,3 +216,602 @@ func BenchmarkReduce_CountOccurrences(b *testing.B) {
 		})
 	}
 }
+
+func ExampleCollectify() {
+	type report struct {
+		count int
+		total int
+		mean  float64
+	}
+	latencies := []int{120, 80, 100}
+
+	summary := slices.Collectify(latencies, func() *report {
+		return &report{}
+	}, func(accumulator *report, element int) {
+		accumulator.count++
+		accumulator.total += element
+	}, func(accumulator *report) report {
+		accumulator.mean = float64(accumulator.total) / float64(accumulator.count)
+		return *accumulator
+	})
+
+	fmt.Printf("count: %v, total: %v, mean: %v", summary.count, summary.total, summary.mean)
+	// Output: count: 3, total: 300, mean: 100
+}
+
+func TestCollectify(t *testing.T) {
+	supplier := func() *strings.Builder {
+		return &strings.Builder{}
+	}
+	accumulate := func(accumulator *strings.Builder, element int) {
+		accumulator.WriteString(strconv.Itoa(element))
+	}
+	finisher := func(accumulator *strings.Builder) string {
+		return "[" + accumulator.String() + "]"
+	}
+	tests := []struct {
+		name  string
+		input []int
+		want  string
+	}{
+		{
+			name:  "accumulates every element then finishes",
+			input: []int{1, 2, 3},
+			want:  "[123]",
+		},
+		{
+			name:  "nil input finishes a fresh accumulator",
+			input: nil,
+			want:  "[]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.Collectify(tt.input, supplier, accumulate, finisher); got != tt.want {
+				t.Errorf("Collectify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFoldMap() {
+	type stats struct {
+		count  int
+		errors int
+	}
+	entries := []string{"INFO ok", "ERROR failed", "INFO ok", "ERROR timeout"}
+
+	total := slices.FoldMap(entries, func(entry string) stats {
+		if strings.HasPrefix(entry, "ERROR") {
+			return stats{count: 1, errors: 1}
+		}
+		return stats{count: 1}
+	}, func(a, b stats) stats {
+		return stats{count: a.count + b.count, errors: a.errors + b.errors}
+	}, stats{})
+
+	fmt.Printf("count: %v, errors: %v", total.count, total.errors)
+	// Output: count: 4, errors: 2
+}
+
+func TestFoldMap(t *testing.T) {
+	type args[T any, A any] struct {
+		input    []T
+		mapFn    slices.MapFunc[T, A]
+		combine  slices.CombineFunc[A]
+		identity A
+	}
+	type testCase[T any, A any] struct {
+		name string
+		args args[T, A]
+		want A
+	}
+	concat := func(a, b string) string {
+		return a + b
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "maps and combines every element in order",
+			args: args[int, string]{
+				input:    []int{1, 2, 3},
+				mapFn:    strconv.Itoa,
+				combine:  concat,
+				identity: "",
+			},
+			want: "123",
+		},
+		{
+			name: "identity is included in the result",
+			args: args[int, string]{
+				input:    []int{4, 5},
+				mapFn:    strconv.Itoa,
+				combine:  concat,
+				identity: ">",
+			},
+			want: ">45",
+		},
+		{
+			name: "empty input provides the identity",
+			args: args[int, string]{
+				input:    []int{},
+				mapFn:    strconv.Itoa,
+				combine:  concat,
+				identity: "none",
+			},
+			want: "none",
+		},
+		{
+			name: "nil input provides the identity",
+			args: args[int, string]{
+				input:    nil,
+				mapFn:    strconv.Itoa,
+				combine:  concat,
+				identity: "none",
+			},
+			want: "none",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FoldMap(tt.args.input, tt.args.mapFn, tt.args.combine, tt.args.identity); got != tt.want {
+				t.Errorf("FoldMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleMapReduce() {
+	records := []string{"alpha", "beta", "gamma"}
+
+	totalBytes := slices.MapReduce(records, func(record string) int {
+		return len(record)
+	}, 0, slices.TotalReducer[int])
+
+	fmt.Printf("total bytes: %v", totalBytes)
+	// Output: total bytes: 14
+}
+
+func TestMapReduce(t *testing.T) {
+	type args[T, M, A any] struct {
+		input    []T
+		mapFn    slices.MapFunc[T, M]
+		initial  A
+		reduceFn slices.ReductionFunc[M, A]
+	}
+	type testCase[T, M, A any] struct {
+		name string
+		args args[T, M, A]
+		want A
+	}
+	parse := func(element string) int {
+		value, _ := strconv.Atoi(element)
+		return value
+	}
+	tests := []testCase[string, int, int]{
+		{
+			name: "maps and reduces each element",
+			args: args[string, int, int]{
+				input:    []string{"1", "20", "300"},
+				mapFn:    parse,
+				initial:  0,
+				reduceFn: slices.TotalReducer[int],
+			},
+			want: 321,
+		},
+		{
+			name: "starts from the initial value",
+			args: args[string, int, int]{
+				input:    []string{"5"},
+				mapFn:    parse,
+				initial:  10,
+				reduceFn: slices.TotalReducer[int],
+			},
+			want: 15,
+		},
+		{
+			name: "nil input provides the initial value",
+			args: args[string, int, int]{
+				input:    nil,
+				mapFn:    parse,
+				initial:  7,
+				reduceFn: slices.TotalReducer[int],
+			},
+			want: 7,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.MapReduce(tt.args.input, tt.args.mapFn, tt.args.initial, tt.args.reduceFn); got != tt.want {
+				t.Errorf("MapReduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleFilterMapReduce() {
+	records := []string{"ok", "", "fine", ""}
+
+	totalBytes := slices.FilterMapReduce(records, func(record string) bool {
+		return record != ""
+	}, func(record string) int {
+		return len(record)
+	}, 0, slices.TotalReducer[int])
+
+	fmt.Printf("total bytes: %v", totalBytes)
+	// Output: total bytes: 6
+}
+
+func TestFilterMapReduce(t *testing.T) {
+	isEven := func(element int) bool {
+		return element%2 == 0
+	}
+	square := func(element int) int {
+		return element * element
+	}
+	tests := []struct {
+		name  string
+		input []int
+		want  int
+	}{
+		{
+			name:  "only includes elements passing the filter",
+			input: []int{1, 2, 3, 4},
+			want:  20,
+		},
+		{
+			name:  "no elements passing the filter provides the initial value",
+			input: []int{1, 3},
+			want:  0,
+		},
+		{
+			name:  "nil input provides the initial value",
+			input: nil,
+			want:  0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.FilterMapReduce(tt.input, isEven, square, 0, slices.TotalReducer[int]); got != tt.want {
+				t.Errorf("FilterMapReduce() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkMapReduce(b *testing.B) {
+	sli := slices.Generate(10_000, slices.NumericIdentityGenerator[int])
+	double := func(element int) int {
+		return element * 2
+	}
+	b.Run("MapReduce", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = slices.MapReduce(sli, double, 0, slices.TotalReducer[int])
+		}
+	})
+	b.Run("Map then Reduce", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_ = slices.Reduce(slices.Map(sli, double), slices.TotalReducer[int])
+		}
+	})
+}
+
+func ExampleReduceUntil() {
+	input := []int{5, 10, 20, 40}
+
+	// Sum elements until the total exceeds 12.
+	total, stoppedAt := slices.ReduceUntil(input, 0, func(accum, currVal int) (int, bool) {
+		accum += currVal
+		return accum, accum <= 12
+	})
+
+	// Resume from where the reduction stopped.
+	rest, _ := slices.ReduceUntil(input[stoppedAt:], 0, func(accum, currVal int) (int, bool) {
+		return accum + currVal, true
+	})
+
+	fmt.Printf("total: %v, stopped at: %v, rest: %v", total, stoppedAt, rest)
+	// Output: total: 15, stopped at: 2, rest: 60
+}
+
+func TestReduceUntil(t *testing.T) {
+	type args[I any, O any] struct {
+		input   []I
+		initial O
+		fn      slices.ReductionUntilFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name          string
+		args          args[I, O]
+		wantResult    O
+		wantStoppedAt int
+	}
+	sumUntilOverTen := func(accum, currVal int) (int, bool) {
+		accum += currVal
+		return accum, accum <= 10
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "stops once the function reports not to continue",
+			args: args[int, int]{
+				input:   []int{4, 5, 6, 7},
+				initial: 0,
+				fn:      sumUntilOverTen,
+			},
+			wantResult:    15,
+			wantStoppedAt: 3,
+		},
+		{
+			name: "runs to completion provides the input length",
+			args: args[int, int]{
+				input:   []int{1, 2, 3},
+				initial: 1,
+				fn:      sumUntilOverTen,
+			},
+			wantResult:    7,
+			wantStoppedAt: 3,
+		},
+		{
+			name: "stopping on the first element provides one",
+			args: args[int, int]{
+				input:   []int{20, 1},
+				initial: 0,
+				fn:      sumUntilOverTen,
+			},
+			wantResult:    20,
+			wantStoppedAt: 1,
+		},
+		{
+			name: "nil input provides the initial value and zero",
+			args: args[int, int]{
+				input:   nil,
+				initial: 3,
+				fn:      sumUntilOverTen,
+			},
+			wantResult:    3,
+			wantStoppedAt: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotResult, gotStoppedAt := slices.ReduceUntil(tt.args.input, tt.args.initial, tt.args.fn)
+			if !reflect.DeepEqual(gotResult, tt.wantResult) {
+				t.Errorf("ReduceUntil() gotResult = %v, want %v", gotResult, tt.wantResult)
+			}
+			if gotStoppedAt != tt.wantStoppedAt {
+				t.Errorf("ReduceUntil() gotStoppedAt = %v, want %v", gotStoppedAt, tt.wantStoppedAt)
+			}
+		})
+	}
+}
+
+func BenchmarkReduceUntil(b *testing.B) {
+	benchmarks := []struct {
+		name string
+		sli  []int
+	}{
+		{
+			name: "3 elements",
+			sli:  []int{1, 2, 3},
+		},
+		{
+			name: "100 elements",
+			sli:  slices.Generate(100, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "10_000 elements",
+			sli:  slices.Generate(10_000, slices.NumericIdentityGenerator[int]),
+		},
+		{
+			name: "1_000_000 elements",
+			sli:  slices.Generate(1_000_000, slices.NumericIdentityGenerator[int]),
+		},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = slices.ReduceUntil(bm.sli, 0, func(accum, currVal int) (int, bool) {
+					return accum + currVal, true
+				})
+			}
+		})
+	}
+}
+
+func ExampleScanIndexed() {
+	readings := []float64{8, 4, 2, 6}
+
+	// Each reading contributes less the later it arrives.
+	decayed := slices.ScanIndexed(readings, 0.0, func(accum float64, index int, currVal float64) float64 {
+		return accum + currVal/float64(index+1)
+	})
+
+	fmt.Println(decayed)
+	// Output: [8 10 10.666666666666666 12.166666666666666]
+}
+
+func TestScanIndexed(t *testing.T) {
+	type args[I any, O any] struct {
+		input   []I
+		initial O
+		fn      slices.IndexedReductionFunc[I, O]
+	}
+	type testCase[I any, O any] struct {
+		name string
+		args args[I, O]
+		want []O
+	}
+	weightedSum := func(accum int, index int, currVal int) int {
+		return accum + index*currVal
+	}
+	tests := []testCase[int, int]{
+		{
+			name: "provides each accumulator",
+			args: args[int, int]{
+				input:   []int{5, 1, 2, 3},
+				initial: 0,
+				fn:      weightedSum,
+			},
+			want: []int{0, 1, 5, 14},
+		},
+		{
+			name: "starts from the initial value",
+			args: args[int, int]{
+				input:   []int{4, 4},
+				initial: 10,
+				fn:      weightedSum,
+			},
+			want: []int{10, 14},
+		},
+		{
+			name: "nil input provides nil",
+			args: args[int, int]{
+				input:   nil,
+				initial: 10,
+				fn:      weightedSum,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := slices.ScanIndexed(tt.args.input, tt.args.initial, tt.args.fn); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ScanIndexed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleParallelFoldOrdered() {
+	fragments := []string{"<h1>", "Title", "</h1>", "<p>", "Body", "</p>"}
+
+	page := slices.ParallelFoldOrdered(fragments, 3, func(chunk []string) string {
+		return strings.Join(chunk, "")
+	}, func(a, b string) string {
+		return a + b
+	})
+
+	fmt.Println(page)
+	// Output: <h1>Title</h1><p>Body</p>
+}
+
+func TestParallelFoldOrdered(t *testing.T) {
+	concatChunk := func(chunk []int) string {
+		var sb strings.Builder
+		for _, element := range chunk {
+			sb.WriteString(strconv.Itoa(element))
+		}
+		return sb.String()
+	}
+	concat := func(a, b string) string {
+		return a + b
+	}
+	type args[T any, A any] struct {
+		input   []T
+		workers int
+	}
+	type testCase[T any, A any] struct {
+		name string
+		args args[T, A]
+		want A
+	}
+	tests := []testCase[int, string]{
+		{
+			name: "combines chunks in input order",
+			args: args[int, string]{
+				input:   slices.Generate(20, slices.NumericIdentityGenerator[int]),
+				workers: 4,
+			},
+			want: "012345678910111213141516171819",
+		},
+		{
+			name: "more workers than elements",
+			args: args[int, string]{
+				input:   []int{1, 2, 3},
+				workers: 10,
+			},
+			want: "123",
+		},
+		{
+			name: "zero workers uses a single worker",
+			args: args[int, string]{
+				input:   []int{1, 2, 3},
+				workers: 0,
+			},
+			want: "123",
+		},
+		{
+			name: "nil input provides the fold of an empty chunk",
+			args: args[int, string]{
+				input:   nil,
+				workers: 4,
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.ParallelFoldOrdered(tt.args.input, tt.args.workers, concatChunk, concat)
+			if got != tt.want {
+				t.Errorf("ParallelFoldOrdered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleTee() {
+	latencies := []int{120, 80, 200, 100}
+
+	total, count := slices.Tee(latencies, slices.Sum[int], slices.Length[int])
+
+	fmt.Printf("total: %v, count: %v", total, count)
+	// Output: total: 500, count: 4
+}
+
+func TestTee(t *testing.T) {
+	isEven := func(element int) bool {
+		return element%2 == 0
+	}
+	type testCase[T, A, B any] struct {
+		name  string
+		input []T
+		fnA   func([]T) A
+		fnB   func([]T) B
+		wantA A
+		wantB B
+	}
+	tests := []testCase[int, []int, int]{
+		{
+			name:  "applies both functions to the input",
+			input: []int{1, 2, 3, 4},
+			fnA: func(input []int) []int {
+				return slices.Filter(input, isEven)
+			},
+			fnB:   slices.Sum[int],
+			wantA: []int{2, 4},
+			wantB: 10,
+		},
+		{
+			name:  "nil input is given to both functions",
+			input: nil,
+			fnA: func(input []int) []int {
+				return slices.Filter(input, isEven)
+			},
+			fnB:   slices.Sum[int],
+			wantA: nil,
+			wantB: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotA, gotB := slices.Tee(tt.input, tt.fnA, tt.fnB)
+			if !reflect.DeepEqual(gotA, tt.wantA) {
+				t.Errorf("Tee() gotA = %v, want %v", gotA, tt.wantA)
+			}
+			if !reflect.DeepEqual(gotB, tt.wantB) {
+				t.Errorf("Tee() gotB = %v, want %v", gotB, tt.wantB)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package slices
+
+import "github.com/pickeringtech/go-collections/maps"
+
+// Zip pairs up the elements of the two inputs by position, with the key of each pair taken from inputA and the value
+// from inputB, stopping when the shorter of the two inputs is exhausted - the remaining elements of the longer input
+// are left out.  Use ZipLongest to keep every position of both inputs.  If either input is empty or nil, the output
+// will be nil.
+func Zip[A comparable, B any](inputA []A, inputB []B) []maps.Entry[A, B] {
+	length := len(inputA)
+	if len(inputB) < length {
+		length = len(inputB)
+	}
+	if length == 0 {
+		return nil
+	}
+	results := make([]maps.Entry[A, B], length)
+	for i := range results {
+		results[i] = maps.Entry[A, B]{Key: inputA[i], Value: inputB[i]}
+	}
+	return results
+}
+
+// ZipLongest pairs up the elements of the two inputs by position, with the key of each pair taken from inputA and the
+// value from inputB, continuing until the longer of the two inputs is exhausted.  Positions beyond the end of the
+// shorter input are padded with the default for that side, so every position of both inputs is represented.  If both
+// inputs are empty or nil, the output will be nil.
+func ZipLongest[A comparable, B any](inputA []A, inputB []B, defaultA A, defaultB B) []maps.Entry[A, B] {
+	length := len(inputA)
+	if len(inputB) > length {
+		length = len(inputB)
+	}
+	if length == 0 {
+		return nil
+	}
+	results := make([]maps.Entry[A, B], length)
+	for i := range results {
+		results[i] = maps.Entry[A, B]{Key: defaultA, Value: defaultB}
+		if i < len(inputA) {
+			results[i].Key = inputA[i]
+		}
+		if i < len(inputB) {
+			results[i].Value = inputB[i]
+		}
+	}
+	return results
+}
@@ -0,0 +1,145 @@
+package slices_test
+
+import (
+	"fmt"
+	"github.com/pickeringtech/go-collections/maps"
+	"github.com/pickeringtech/go-collections/slices"
+	"reflect"
+	"testing"
+)
+
+func ExampleZip() {
+	requestIDs := []string{"req-1", "req-2", "req-3"}
+	statuses := []int{200, 404}
+
+	pairs := slices.Zip(requestIDs, statuses)
+	fmt.Printf("pairs: %v", pairs)
+	// Output: pairs: [{req-1 200} {req-2 404}]
+}
+
+func TestZip(t *testing.T) {
+	type args[A comparable, B any] struct {
+		inputA []A
+		inputB []B
+	}
+	type testCase[A comparable, B any] struct {
+		name string
+		args args[A, B]
+		want []maps.Entry[A, B]
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "equal lengths pair every element",
+			args: args[string, int]{
+				inputA: []string{"a", "b"},
+				inputB: []int{1, 2},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name: "longer first input is cut short",
+			args: args[string, int]{
+				inputA: []string{"a", "b", "c"},
+				inputB: []int{1},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}},
+		},
+		{
+			name: "longer second input is cut short",
+			args: args[string, int]{
+				inputA: []string{"a"},
+				inputB: []int{1, 2, 3},
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}},
+		},
+		{
+			name: "nil input provides nil",
+			args: args[string, int]{
+				inputA: nil,
+				inputB: []int{1, 2},
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.Zip(tt.args.inputA, tt.args.inputB)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Zip() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func ExampleZipLongest() {
+	oldValues := []string{"a", "b"}
+	newValues := []int{1, 2, 3}
+
+	aligned := slices.ZipLongest(oldValues, newValues, "-", 0)
+	fmt.Printf("aligned: %v", aligned)
+	// Output: aligned: [{a 1} {b 2} {- 3}]
+}
+
+func TestZipLongest(t *testing.T) {
+	type args[A comparable, B any] struct {
+		inputA   []A
+		inputB   []B
+		defaultA A
+		defaultB B
+	}
+	type testCase[A comparable, B any] struct {
+		name string
+		args args[A, B]
+		want []maps.Entry[A, B]
+	}
+	tests := []testCase[string, int]{
+		{
+			name: "equal lengths pair every element",
+			args: args[string, int]{
+				inputA:   []string{"a", "b"},
+				inputB:   []int{1, 2},
+				defaultA: "-",
+				defaultB: -1,
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: 2}},
+		},
+		{
+			name: "shorter second input is padded",
+			args: args[string, int]{
+				inputA:   []string{"a", "b", "c"},
+				inputB:   []int{1},
+				defaultA: "-",
+				defaultB: -1,
+			},
+			want: []maps.Entry[string, int]{{Key: "a", Value: 1}, {Key: "b", Value: -1}, {Key: "c", Value: -1}},
+		},
+		{
+			name: "nil first input is padded entirely",
+			args: args[string, int]{
+				inputA:   nil,
+				inputB:   []int{1, 2},
+				defaultA: "-",
+				defaultB: -1,
+			},
+			want: []maps.Entry[string, int]{{Key: "-", Value: 1}, {Key: "-", Value: 2}},
+		},
+		{
+			name: "both inputs nil provides nil",
+			args: args[string, int]{
+				inputA:   nil,
+				inputB:   nil,
+				defaultA: "-",
+				defaultB: -1,
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := slices.ZipLongest(tt.args.inputA, tt.args.inputB, tt.args.defaultA, tt.args.defaultB)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ZipLongest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}